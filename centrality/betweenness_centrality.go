@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package centrality
+
+import (
+	"sort"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/entity"
+	"github.com/fitm-elite/grafik/options"
+	"github.com/fitm-elite/grafik/queue"
+)
+
+// brandesState keeps the single-source shortest paths data that is
+// needed by Brandes' algorithm.
+type brandesState[T comparable] struct {
+	stack        []T           // vertices in order of non-decreasing distance from the source.
+	predecessors map[T][]T     // predecessors of each vertex on the shortest paths.
+	sigma        map[T]float64 // number of shortest paths from the source to each vertex.
+}
+
+func newBrandesState[T comparable](source T) *brandesState[T] {
+	return &brandesState[T]{
+		predecessors: make(map[T][]T),
+		sigma:        map[T]float64{source: 1},
+	}
+}
+
+// Betweenness It's using Brandes' algorithm to find the betweenness centrality of
+// each vertex, which is the fraction of shortest paths between all pairs of other
+// vertices that pass through the vertex.
+//
+// By default, every edge has a length of one and the shortest paths are found
+// using breadth-first search. Use options.WithBetweennessWeighted to use the edge
+// weights with Dijkstra's algorithm instead, and options.WithBetweennessNormalized
+// to scale the scores by the number of vertex pairs.
+//
+// Return []VertexScore[T] sorted from the most central vertex.
+func Betweenness[T comparable](g entity.Grafik[T], opts ...options.BetweennessOptionFunc) []grafik.VertexScore[T] {
+	g = grafik.Snapshot[T](g)
+
+	var properties options.BetweennessProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	vertices := g.GetAllVertices()
+	scores := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		scores[v.Label()] = 0
+	}
+
+	for _, s := range vertices {
+		var state *brandesState[T]
+		if properties.IsWeighted() {
+			state = brandesDijkstra(g, s)
+		} else {
+			state = brandesBreadthFirst(s)
+		}
+
+		// accumulate the dependencies in order of non-increasing distance.
+		delta := make(map[T]float64, len(state.stack))
+		for i := len(state.stack) - 1; i >= 0; i-- {
+			w := state.stack[i]
+			for _, v := range state.predecessors[w] {
+				delta[v] += state.sigma[v] / state.sigma[w] * (1 + delta[w])
+			}
+
+			if w != s.Label() {
+				scores[w] += delta[w]
+			}
+		}
+	}
+
+	// every pair has been counted in both directions in undirected graph.
+	scale := 1.0
+	if !g.IsDirected() {
+		scale = 0.5
+	}
+
+	// normalize by the number of pairs that don't include the vertex.
+	if n := float64(len(vertices)); properties.IsNormalized() && n > 2 {
+		pairs := (n - 1) * (n - 2)
+		if !g.IsDirected() {
+			pairs /= 2
+		}
+
+		scale /= pairs
+	}
+
+	vertexScores := make([]grafik.VertexScore[T], 0, len(vertices))
+	for label, score := range scores {
+		vertexScores = append(vertexScores, grafik.VertexScore[T]{
+			VertexLabel: label,
+			Score:       score * scale,
+		})
+	}
+
+	sort.Slice(vertexScores, func(i, j int) bool {
+		return vertexScores[i].Score > vertexScores[j].Score
+	})
+
+	return vertexScores
+}
+
+// brandesBreadthFirst finds the shortest paths from the source vertex
+// in unweighted graph.
+func brandesBreadthFirst[T comparable](s *grafik.Vertex[T]) *brandesState[T] {
+	state := newBrandesState(s.Label())
+	dist := map[T]int{s.Label(): 0}
+
+	fifo := []*grafik.Vertex[T]{s}
+	for head := 0; head < len(fifo); head++ {
+		v := fifo[head]
+		state.stack = append(state.stack, v.Label())
+
+		for _, w := range v.Neighbors() {
+			if _, ok := dist[w.Label()]; !ok {
+				dist[w.Label()] = dist[v.Label()] + 1
+				fifo = append(fifo, w)
+			}
+
+			if dist[w.Label()] == dist[v.Label()]+1 {
+				state.sigma[w.Label()] += state.sigma[v.Label()]
+				state.predecessors[w.Label()] = append(state.predecessors[w.Label()], v.Label())
+			}
+		}
+	}
+
+	return state
+}
+
+// brandesDijkstra finds the shortest paths from the source vertex
+// in weighted graph.
+func brandesDijkstra[T comparable](g entity.Grafik[T], s *grafik.Vertex[T]) *brandesState[T] {
+	state := newBrandesState(s.Label())
+	dist := map[T]float64{s.Label(): 0}
+	settled := make(map[T]bool)
+
+	pq := queue.NewVertexPriorityQueue[T]()
+	pq.Push(queue.NewVertexWithPriority(s, 0))
+
+	for pq.Len() > 0 {
+		curr := pq.Pop()
+		v := curr.Vertex()
+		if settled[v.Label()] {
+			continue
+		}
+
+		settled[v.Label()] = true
+		state.stack = append(state.stack, v.Label())
+
+		for _, w := range v.Neighbors() {
+			alt := dist[v.Label()] + g.GetEdge(v, w).Weight()
+
+			d, ok := dist[w.Label()]
+			switch {
+			case !ok || alt < d:
+				dist[w.Label()] = alt
+				state.sigma[w.Label()] = state.sigma[v.Label()]
+				state.predecessors[w.Label()] = []T{v.Label()}
+				pq.Push(queue.NewVertexWithPriority(w, alt))
+			case alt == d && !settled[w.Label()]:
+				state.sigma[w.Label()] += state.sigma[v.Label()]
+				state.predecessors[w.Label()] = append(state.predecessors[w.Label()], v.Label())
+			}
+		}
+	}
+
+	return state
+}
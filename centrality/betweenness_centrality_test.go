@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package centrality
+
+import (
+	"math"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func scoresOf[T comparable](vertexScores []grafik.VertexScore[T]) map[T]float64 {
+	scores := make(map[T]float64, len(vertexScores))
+	for _, vertexScore := range vertexScores {
+		scores[vertexScore.GetLabel()] = vertexScore.GetScore()
+	}
+
+	return scores
+}
+
+func TestBetweenness(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vB, vC)
+	_, _ = g.AddEdge(vC, vD)
+	_, _ = g.AddEdge(vD, vE)
+
+	scores := Betweenness(g)
+	if len(scores) != 5 {
+		t.Errorf("Expected len from scores is %d, got %d", 5, len(scores))
+	}
+
+	if scores[0].GetLabel() != vC.Label() || scores[0].GetScore() != 4 {
+		t.Errorf("Expected %s (%.2f), got %s (%.2f)", vC.Label(), 4.0, scores[0].GetLabel(), scores[0].GetScore())
+	}
+
+	expected := map[string]float64{"A": 0, "B": 3, "C": 4, "D": 3, "E": 0}
+	for label, score := range scoresOf(scores) {
+		if score != expected[label] {
+			t.Errorf("Expected %s (%.2f), got %s (%.2f)", label, expected[label], label, score)
+		}
+	}
+
+	normalized := scoresOf(Betweenness(g, options.WithBetweennessNormalized()))
+	if math.Abs(normalized["C"]-4.0/6.0) > 1e-9 {
+		t.Errorf("Expected %s (%.2f), got %s (%.2f)", "C", 4.0/6.0, "C", normalized["C"])
+	}
+}
+
+func TestBetweennessSelfLoop(t *testing.T) {
+	expected := map[string]float64{"A": 0, "B": 1, "C": 0}
+
+	// a self-loop is either allowed or rejected, and is on no shortest path.
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithSelfLoopsRejected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C")
+
+		_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+		_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+		_, _ = g.AddEdge(vB, vB, options.WithEdgeWeight(0))
+		_, _ = g.AddEdge(vC, vC, options.WithEdgeWeight(1))
+
+		for _, betweennessOpts := range [][]options.BetweennessOptionFunc{nil, {options.WithBetweennessWeighted()}} {
+			for label, score := range scoresOf(Betweenness(g, betweennessOpts...)) {
+				if score != expected[label] {
+					t.Errorf("Expected %s (%.2f), got %s (%.2f)", label, expected[label], label, score)
+				}
+			}
+		}
+
+		for _, vertexPath := range DijkstraCentrality(g, options.WithDijkstraStandard()) {
+			expectedLength := map[string]float64{"A": 1, "B": 2.0 / 3.0, "C": 1}[vertexPath.GetLabel()]
+			if vertexPath.GetAverageLength() != expectedLength {
+				t.Errorf("Expected %s (%.2f), got %s (%.2f)", vertexPath.GetLabel(), expectedLength, vertexPath.GetLabel(), vertexPath.GetAverageLength())
+			}
+		}
+	}
+}
+
+func TestBetweennessWithTies(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vD, options.WithEdgeWeight(1))
+
+	for _, opts := range [][]options.BetweennessOptionFunc{nil, {options.WithBetweennessWeighted()}} {
+		for label, score := range scoresOf(Betweenness(g, opts...)) {
+			if score != 0.5 {
+				t.Errorf("Expected %s (%.2f), got %s (%.2f)", label, 0.5, label, score)
+			}
+		}
+	}
+}
+
+func TestBetweennessWeighted(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(3))
+
+	if scores := scoresOf(Betweenness(g)); scores["B"] != 0 {
+		t.Errorf("Expected %s (%.2f), got %s (%.2f)", "B", 0.0, "B", scores["B"])
+	}
+
+	if scores := scoresOf(Betweenness(g, options.WithBetweennessWeighted())); scores["B"] != 1 {
+		t.Errorf("Expected %s (%.2f), got %s (%.2f)", "B", 1.0, "B", scores["B"])
+	}
+}
+
+func TestBetweennessOfDirected(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vB, vC)
+
+	scores := scoresOf(Betweenness(g))
+	if scores["B"] != 1 || scores["A"] != 0 || scores["C"] != 0 {
+		t.Errorf("Expected B (1.00) and others (0.00), got %v", scores)
+	}
+
+	scores = scoresOf(Betweenness(g, options.WithBetweennessNormalized(), options.WithBetweennessWeighted()))
+	if scores["B"] != 0.5 {
+		t.Errorf("Expected %s (%.2f), got %s (%.2f)", "B", 0.5, "B", scores["B"])
+	}
+}
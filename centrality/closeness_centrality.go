@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package centrality
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/entity"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// Closeness It's using a dijkstra method to find shortest path from each vertex
+// and calculate the closeness centrality, which is higher for the vertices that
+// are closer to the others. Unreachable vertices are excluded.
+//
+// By default, the closeness is the number of reachable vertices divided by the
+// sum of the distances to them. Use options.WithClosenessWassermanFaust to scale
+// it by the fraction of reachable vertices, which makes the scores comparable
+// across components, or options.WithClosenessHarmonic to average the inverse
+// distances instead.
+//
+// The edges of an unweighted graph have zero weight, so their distances are
+// zero too. A zero distance adds nothing to the harmonic sum, and a vertex
+// whose distances are all zero scores 0.
+//
+// Return []VertexScore[T] sorted from the most central vertex.
+func Closeness[T comparable](g entity.Grafik[T], opts ...options.CentralityOptionFunc) []grafik.VertexScore[T] {
+	vertexScores, _ := ClosenessContext(context.Background(), g, opts...)
+	return vertexScores
+}
+
+// ClosenessContext is like Closeness, but it stops once the context is done.
+//
+// If the context is done before every vertex has been processed, returns the context error.
+func ClosenessContext[T comparable](ctx context.Context, g entity.Grafik[T], opts ...options.CentralityOptionFunc) ([]grafik.VertexScore[T], error) {
+	g = grafik.Snapshot[T](g)
+
+	var properties options.CentralityProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	n := len(g.GetAllVertices())
+
+	vertexScores, err := forEachSource(ctx, g, properties, func(label T, pathLengths map[T]float64) grafik.VertexScore[T] {
+		return grafik.VertexScore[T]{
+			VertexLabel: label,
+			Score:       closeness(label, pathLengths, n, properties),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(vertexScores, func(i, j int) bool {
+		return vertexScores[i].Score > vertexScores[j].Score
+	})
+
+	return vertexScores, nil
+}
+
+// closeness calculates the closeness of the source vertex with the
+// selected formula from the path lengths to the other vertices.
+func closeness[T comparable](label T, pathLengths map[T]float64, n int, properties options.CentralityProperties) float64 {
+	var totalLength, totalInverse float64
+	var reachable int
+	for other, length := range pathLengths {
+		if other == label || length == math.MaxFloat64 {
+			continue
+		}
+
+		totalLength += length
+		reachable++
+
+		// the inverse of a zero distance is infinite, so it is skipped.
+		if length > 0 {
+			totalInverse += 1 / length
+		}
+	}
+
+	if reachable == 0 {
+		return 0
+	}
+
+	switch {
+	case properties.IsHarmonic():
+		return totalInverse / float64(n-1)
+	case totalLength == 0:
+		return 0
+	case properties.IsWassermanFaust():
+		return float64(reachable) / float64(n-1) * float64(reachable) / totalLength
+	default:
+		return float64(reachable) / totalLength
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package centrality
+
+import (
+	"math"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestCloseness(t *testing.T) {
+	g := newDisconnectedGrafik()
+
+	tests := []struct {
+		opts     []options.CentralityOptionFunc
+		expected map[string]float64
+	}{
+		{
+			opts:     nil,
+			expected: map[string]float64{"A": 2.0 / 3.0, "B": 1, "C": 2.0 / 3.0, "X": 1, "Y": 1, "Z": 0},
+		},
+		{
+			opts:     []options.CentralityOptionFunc{options.WithClosenessWassermanFaust()},
+			expected: map[string]float64{"A": 4.0 / 15.0, "B": 0.4, "C": 4.0 / 15.0, "X": 0.2, "Y": 0.2, "Z": 0},
+		},
+		{
+			opts:     []options.CentralityOptionFunc{options.WithClosenessHarmonic(), options.WithCentralityDijkstra(options.WithDijkstraStandard())},
+			expected: map[string]float64{"A": 0.3, "B": 0.4, "C": 0.3, "X": 0.2, "Y": 0.2, "Z": 0},
+		},
+	}
+
+	for _, test := range tests {
+		scores := Closeness(g, test.opts...)
+		if len(scores) != 6 {
+			t.Errorf("Expected len from scores is %d, got %d", 6, len(scores))
+		}
+
+		for _, score := range scores {
+			if math.Abs(score.GetScore()-test.expected[score.GetLabel()]) > 1e-9 {
+				t.Errorf("Expected %s (%.2f), got %s (%.2f)", score.GetLabel(), test.expected[score.GetLabel()], score.GetLabel(), score.GetScore())
+			}
+		}
+
+		if scores[len(scores)-1].GetLabel() != "Z" {
+			t.Errorf("Expected %s as the last vertex, got %s", "Z", scores[len(scores)-1].GetLabel())
+		}
+	}
+
+	// B is the only centroid once the component size is considered.
+	scores := Closeness(g, options.WithClosenessWassermanFaust())
+	if scores[0].GetLabel() != "B" {
+		t.Errorf("Expected %s as a centroid, got %s", "B", scores[0].GetLabel())
+	}
+}
+
+func TestClosenessOfUnweighted(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vB, vC)
+
+	for _, opts := range [][]options.CentralityOptionFunc{nil, {options.WithClosenessWassermanFaust()}, {options.WithClosenessHarmonic()}} {
+		for _, score := range Closeness(g, opts...) {
+			if score.GetScore() != 0 {
+				t.Errorf("Expected %s (0.00) with zero distances, got %s (%.2f)", score.GetLabel(), score.GetLabel(), score.GetScore())
+			}
+		}
+	}
+}
@@ -21,10 +21,14 @@
 package centrality
 
 import (
+	"context"
+	"math"
+	"runtime"
 	"sort"
 	"sync"
 
 	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/components"
 	"github.com/fitm-elite/grafik/entity"
 	"github.com/fitm-elite/grafik/options"
 	"github.com/fitm-elite/grafik/pathfinder"
@@ -33,36 +37,159 @@ import (
 // DijkstraCentrality It's using a dijkstra method to find shortest path in each vertex
 // and calculate to find an average value in each path to find a centroid.
 //
+// Only the vertices that can be reached are counted in the average, so it stays
+// finite in disconnected graph. If a vertex can't reach any other vertex, its
+// average is math.MaxFloat64.
+//
 // Return []VertexPath[T]
 func DijkstraCentrality[T comparable](g entity.Grafik[T], opts ...options.DijkstraOptionFunc) []grafik.VertexPath[T] {
+	vertexPaths, _ := DijkstraCentralityContext(context.Background(), g, options.WithCentralityDijkstra(opts...))
+	return vertexPaths
+}
+
+// DijkstraCentralityContext is like DijkstraCentrality, but it runs dijkstra on a bounded
+// number of workers and stops once the context is done. Use options.WithCentralityWorkers
+// to set the number of workers, and options.WithCentralityProgress to follow the progress.
+//
+// If the context is done before every vertex has been processed, returns the context error.
+func DijkstraCentralityContext[T comparable](ctx context.Context, g entity.Grafik[T], opts ...options.CentralityOptionFunc) ([]grafik.VertexPath[T], error) {
+	g = grafik.Snapshot[T](g)
+
+	var properties options.CentralityProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	vertexPaths, err := forEachSource(ctx, g, properties, averageLength[T])
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(vertexPaths, func(i, j int) bool {
+		return vertexPaths[i].AverageLength < vertexPaths[j].AverageLength
+	})
+
+	return vertexPaths, nil
+}
+
+// ComponentCentrality It's using DijkstraCentrality to find the average path length
+// of each vertex, and then groups the vertices by connected component. In directed
+// graph, the edge direction is ignored when the components are found.
+//
+// Each component is sorted like DijkstraCentrality, so the first vertex of each
+// component is its centroid. The components are sorted from the largest one.
+//
+// Return [][]VertexPath[T]
+func ComponentCentrality[T comparable](g entity.Grafik[T], opts ...options.DijkstraOptionFunc) [][]grafik.VertexPath[T] {
+	components, _ := ComponentCentralityContext(context.Background(), g, options.WithCentralityDijkstra(opts...))
+	return components
+}
+
+// ComponentCentralityContext is like ComponentCentrality, but it accepts the same
+// options and context as DijkstraCentralityContext.
+//
+// If the context is done before every vertex has been processed, returns the context error.
+func ComponentCentralityContext[T comparable](ctx context.Context, g entity.Grafik[T], opts ...options.CentralityOptionFunc) ([][]grafik.VertexPath[T], error) {
+	g = grafik.Snapshot[T](g)
+
+	connected := components.Connected[T](g)
+
+	componentOf := make(map[T]int)
+	for i, component := range connected {
+		for _, label := range component {
+			componentOf[label] = i
+		}
+	}
+
+	paths, err := DijkstraCentralityContext(ctx, g, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	vertexPaths := make([][]grafik.VertexPath[T], len(connected))
+	for _, vertexPath := range paths {
+		i := componentOf[vertexPath.VertexLabel]
+		vertexPaths[i] = append(vertexPaths[i], vertexPath)
+	}
+
+	return vertexPaths, nil
+}
+
+// averageLength calculates the average length of the paths to the reachable
+// vertices, including the source vertex itself.
+func averageLength[T comparable](label T, pathLengths map[T]float64) grafik.VertexPath[T] {
+	var totalLength float64
+	var reachable int
+	for _, length := range pathLengths {
+		if length == math.MaxFloat64 {
+			continue
+		}
+
+		totalLength += length
+		reachable++
+	}
+
+	averageLength := math.MaxFloat64
+	if reachable > 1 {
+		averageLength = totalLength / float64(reachable)
+	}
+
+	return grafik.VertexPath[T]{
+		VertexLabel:   label,
+		AverageLength: averageLength,
+	}
+}
+
+// forEachSource runs dijkstra from every vertex in the graph on a pool of
+// workers, and reduces the path lengths of each run with the input function.
+// Each worker keeps at most one distance map at a time.
+//
+// If the context is done before every vertex has been processed, returns
+// the context error.
+func forEachSource[T comparable, R any](ctx context.Context, g entity.Grafik[T], properties options.CentralityProperties, reduce func(label T, pathLengths map[T]float64) R) ([]R, error) {
 	vertices := g.GetAllVertices()
-	vertexPaths := make([]grafik.VertexPath[T], 0, len(vertices))
+	reduced := make([]R, 0, len(vertices))
+
+	workers := properties.GetWorkers()
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
 
-	var mu sync.Mutex
 	var wg sync.WaitGroup
 
-	results := make(chan grafik.VertexPath[T], len(vertices))
+	jobs := make(chan *grafik.Vertex[T])
+	results := make(chan R)
 
-	wg.Add(len(vertices))
-	for _, v := range vertices {
-		go func(v *grafik.Vertex[T]) {
+	go func() {
+		defer close(jobs)
+		for _, v := range vertices {
+			select {
+			case jobs <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
 			defer wg.Done()
-			label := v.Label()
-			pathLengths := pathfinder.Dijkstra(g, label, opts...)
+			for v := range jobs {
+				if ctx.Err() != nil {
+					return
+				}
 
-			var totalLength float64
-			for _, length := range pathLengths {
-				totalLength += length
-			}
+				label := v.Label()
+				pathLengths := pathfinder.Dijkstra(g, label, properties.GetDijkstraOptions()...)
 
-			averageLength := totalLength / float64(len(pathLengths))
-			result := grafik.VertexPath[T]{
-				VertexLabel:   label,
-				AverageLength: averageLength,
+				select {
+				case results <- reduce(label, pathLengths):
+				case <-ctx.Done():
+					return
+				}
 			}
-
-			results <- result
-		}(v)
+		}()
 	}
 
 	go func() {
@@ -70,15 +197,17 @@ func DijkstraCentrality[T comparable](g entity.Grafik[T], opts ...options.Dijkst
 		close(results)
 	}()
 
+	progress := properties.GetProgress()
 	for result := range results {
-		mu.Lock()
-		vertexPaths = append(vertexPaths, result)
-		mu.Unlock()
+		reduced = append(reduced, result)
+		if progress != nil {
+			progress(len(reduced), len(vertices))
+		}
 	}
 
-	sort.Slice(vertexPaths, func(i, j int) bool {
-		return vertexPaths[i].AverageLength < vertexPaths[j].AverageLength
-	})
+	if len(reduced) < len(vertices) {
+		return nil, ctx.Err()
+	}
 
-	return vertexPaths
+	return reduced, nil
 }
@@ -21,6 +21,10 @@
 package centrality
 
 import (
+	"context"
+	"errors"
+	"math"
+	"sync"
 	"testing"
 
 	"github.com/fitm-elite/grafik"
@@ -68,3 +72,256 @@ func TestDijkstraCentrality(t *testing.T) {
 		t.Errorf("Expected %s (%.2f), got %s (%.2f)", vB.Label(), 2.14, paths[0].GetLabel(), paths[0].GetAverageLength())
 	}
 }
+
+func TestDijkstraCentralityOfConcurrent(t *testing.T) {
+	const n = 30
+
+	g := grafik.NewConcurrent[int]()
+	for i := 0; i < n; i++ {
+		_ = g.AddVertexByLabel(i)
+	}
+
+	for i := 1; i < n; i++ {
+		_, _ = g.AddEdge(grafik.NewVertex(i-1), grafik.NewVertex(i), options.WithEdgeWeight(1))
+	}
+
+	var wg sync.WaitGroup
+
+	// add shortcut edges while the centrality is running.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 2; i < n; i++ {
+			_, _ = g.AddEdge(grafik.NewVertex(0), grafik.NewVertex(i), options.WithEdgeWeight(1))
+		}
+	}()
+
+	for _, opts := range [][]options.DijkstraOptionFunc{nil, {options.WithDijkstraStandard()}} {
+		wg.Add(1)
+		go func(opts []options.DijkstraOptionFunc) {
+			defer wg.Done()
+			if paths := DijkstraCentrality(g, opts...); len(paths) != n {
+				t.Errorf("Expected len from paths is %d, got %d", n, len(paths))
+			}
+		}(opts)
+	}
+
+	wg.Wait()
+
+	paths := DijkstraCentrality(g, options.WithDijkstraStandard())
+	if paths[0].GetLabel() != 0 {
+		t.Errorf("Expected %d as a centroid, got %d", 0, paths[0].GetLabel())
+	}
+}
+
+func TestDijkstraCentralityOfChangingConcurrent(t *testing.T) {
+	const n = 30
+
+	g := grafik.NewConcurrent[int](options.WithDirected())
+	for i := 0; i < n; i++ {
+		_ = g.AddVertexByLabel(i)
+	}
+
+	for i := 1; i < n; i++ {
+		_, _ = g.AddEdge(grafik.NewVertex(i-1), grafik.NewVertex(i), options.WithEdgeWeight(1))
+	}
+
+	var wg sync.WaitGroup
+
+	done := make(chan struct{})
+
+	// add and remove vertices and edges until the centrality has finished.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 1; ; i = i%(n-1) + 1 {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			_, _ = g.AddEdge(grafik.NewVertex(i), grafik.NewVertex(n+i), options.WithEdgeWeight(1))
+			_ = g.RemoveEdge(grafik.NewVertex(i-1), grafik.NewVertex(i))
+			_ = g.RemoveVertexByLabel(n + i)
+			_, _ = g.AddEdge(grafik.NewVertex(i-1), grafik.NewVertex(i), options.WithEdgeWeight(1))
+		}
+	}()
+
+	var readers sync.WaitGroup
+	for _, opts := range [][]options.DijkstraOptionFunc{nil, {options.WithDijkstraStandard()}} {
+		readers.Add(1)
+		go func(opts []options.DijkstraOptionFunc) {
+			defer readers.Done()
+			for i := 0; i < 20; i++ {
+				if paths := DijkstraCentrality(g, opts...); len(paths) < n {
+					t.Errorf("Expected at least %d paths, got %d", n, len(paths))
+				}
+			}
+		}(opts)
+	}
+
+	readers.Wait()
+	close(done)
+	wg.Wait()
+
+	if paths := DijkstraCentrality(g); len(paths) != n {
+		t.Errorf("Expected len from paths is %d, got %d", n, len(paths))
+	}
+}
+
+func newDisconnectedGrafik() grafik.Grafik[string] {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vX := g.AddVertexByLabel("X")
+	vY := g.AddVertexByLabel("Y")
+	_ = g.AddVertexByLabel("Z")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vX, vY, options.WithEdgeWeight(1))
+
+	return g
+}
+
+func TestDijkstraCentralityOfDisconnected(t *testing.T) {
+	g := newDisconnectedGrafik()
+
+	paths := DijkstraCentrality(g, options.WithDijkstraStandard())
+	if len(paths) != 6 {
+		t.Errorf("Expected len from paths is %d, got %d", 6, len(paths))
+	}
+
+	expected := map[string]float64{"A": 1, "B": 2.0 / 3.0, "C": 1, "X": 0.5, "Y": 0.5, "Z": math.MaxFloat64}
+	for _, path := range paths {
+		if math.Abs(path.GetAverageLength()-expected[path.GetLabel()]) > 1e-9 {
+			t.Errorf("Expected %s (%.2f), got %s (%.2f)", path.GetLabel(), expected[path.GetLabel()], path.GetLabel(), path.GetAverageLength())
+		}
+	}
+
+	if paths[len(paths)-1].GetLabel() != "Z" {
+		t.Errorf("Expected %s as the last vertex, got %s", "Z", paths[len(paths)-1].GetLabel())
+	}
+}
+
+func TestComponentCentrality(t *testing.T) {
+	g := newDisconnectedGrafik()
+
+	components := ComponentCentrality(g)
+	if len(components) != 3 {
+		t.Fatalf("Expected len from components is %d, got %d", 3, len(components))
+	}
+
+	for i, size := range []int{3, 2, 1} {
+		if len(components[i]) != size {
+			t.Errorf("Expected component %d to have %d vertices, got %d", i, size, len(components[i]))
+		}
+	}
+
+	if components[0][0].GetLabel() != "B" {
+		t.Errorf("Expected %s as a centroid, got %s", "B", components[0][0].GetLabel())
+	}
+
+	if components[2][0].GetLabel() != "Z" {
+		t.Errorf("Expected %s as a centroid, got %s", "Z", components[2][0].GetLabel())
+	}
+}
+
+func newPathGrafik(n int) grafik.Grafik[int] {
+	g := grafik.New[int]()
+	for i := 0; i < n; i++ {
+		_ = g.AddVertexByLabel(i)
+	}
+
+	for i := 1; i < n; i++ {
+		_, _ = g.AddEdge(grafik.NewVertex(i-1), grafik.NewVertex(i), options.WithEdgeWeight(1))
+	}
+
+	return g
+}
+
+func TestDijkstraCentralityContext(t *testing.T) {
+	const n = 10
+
+	g := newPathGrafik(n)
+
+	var calls []int
+	paths, err := DijkstraCentralityContext(
+		context.Background(),
+		g,
+		options.WithCentralityWorkers(2),
+		options.WithCentralityDijkstra(options.WithDijkstraStandard()),
+		options.WithCentralityProgress(func(done, total int) {
+			if total != n {
+				t.Errorf("Expected total to be %d, got %d", n, total)
+			}
+			calls = append(calls, done)
+		}),
+	)
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	if len(paths) != n {
+		t.Errorf("Expected len from paths is %d, got %d", n, len(paths))
+	}
+
+	if len(calls) != n {
+		t.Errorf("Expected progress to be called %d times, got %d", n, len(calls))
+	}
+
+	for i, done := range calls {
+		if done != i+1 {
+			t.Errorf("Expected progress %d, got %d", i+1, done)
+		}
+	}
+
+	// the middle vertices are the centroids of a path.
+	if label := paths[0].GetLabel(); label != 4 && label != 5 {
+		t.Errorf("Expected 4 or 5 as a centroid, got %d", label)
+	}
+}
+
+func TestDijkstraCentralityContextCancel(t *testing.T) {
+	const n = 10
+
+	g := newPathGrafik(n)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := DijkstraCentralityContext(ctx, g); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %s, but got %v", context.Canceled, err)
+	}
+
+	// cancel while the centrality is running.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	var done int
+	_, err := DijkstraCentralityContext(ctx, g, options.WithCentralityWorkers(1), options.WithCentralityProgress(func(d, _ int) {
+		done = d
+		cancel()
+	}))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %s, but got %v", context.Canceled, err)
+	}
+
+	if done >= n {
+		t.Errorf("Expected the centrality to stop early, but %d vertices are done", done)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	if _, err = ClosenessContext(ctx, g); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %s, but got %v", context.DeadlineExceeded, err)
+	}
+
+	if _, err = ComponentCentralityContext(ctx, g); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %s, but got %v", context.DeadlineExceeded, err)
+	}
+}
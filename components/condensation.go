@@ -0,0 +1,133 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package components
+
+import (
+	"slices"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// Condensation represents the condensation of a directed graph, which is
+// the directed acyclic graph of its strongly connected components. Each
+// component is contracted into a single vertex labeled by the index of
+// the component.
+//
+// The condensation is a new graph, and the components hold the labels of
+// the input graph, so nothing in it belongs to the grafik.Snapshot that a
+// concurrent graph is condensed on.
+type Condensation[T comparable] struct {
+	graph       grafik.Grafik[int]
+	components  [][]T
+	componentOf map[T]int
+}
+
+// Condense returns the condensation of the graph. The components are
+// indexed in topological order, so every edge of the condensation goes
+// from a lower index to a higher one.
+//
+// There is an edge between two components if there is any edge between
+// their vertices. Its weight is the smallest weight of those edges.
+func Condense[T comparable](g grafik.Grafik[T]) *Condensation[T] {
+	g = grafik.Snapshot(g)
+
+	components := Tarjan(g)
+
+	// Tarjan finds the components in reverse topological order.
+	slices.Reverse(components)
+
+	c := &Condensation[T]{
+		graph:       grafik.New[int](options.WithDirected()),
+		components:  components,
+		componentOf: make(map[T]int),
+	}
+
+	for i, component := range components {
+		c.graph.AddVertexByLabel(i)
+		for _, label := range component {
+			c.componentOf[label] = i
+		}
+	}
+
+	// weights keeps the smallest weight of the edges between each pair of components.
+	weights := make(map[int]map[int]float64)
+	order := make([][2]int, 0)
+	for _, v := range g.GetAllVertices() {
+		from := c.componentOf[v.Label()]
+		for _, neighbor := range v.Neighbors() {
+			to := c.componentOf[neighbor.Label()]
+			if from == to {
+				continue
+			}
+
+			weight := g.GetEdge(v, neighbor).Weight()
+			if _, ok := weights[from]; !ok {
+				weights[from] = make(map[int]float64)
+			}
+
+			if current, ok := weights[from][to]; !ok {
+				weights[from][to] = weight
+				order = append(order, [2]int{from, to})
+			} else if weight < current {
+				weights[from][to] = weight
+			}
+		}
+	}
+
+	for _, pair := range order {
+		from, to := c.graph.GetVertexByLabel(pair[0]), c.graph.GetVertexByLabel(pair[1])
+		_, _ = c.graph.AddEdge(from, to, options.WithEdgeWeight(weights[pair[0]][pair[1]]))
+	}
+
+	return c
+}
+
+// Grafik returns the condensation as a directed acyclic graph, whose
+// vertices are labeled by the component indexes.
+func (c *Condensation[T]) Grafik() grafik.Grafik[int] {
+	return c.graph
+}
+
+// Components returns the labels of the vertices in each component, indexed
+// in topological order.
+func (c *Condensation[T]) Components() [][]T {
+	return c.components
+}
+
+// Component returns the labels of the vertices in the component with the
+// input index, or nil if the index is out of range.
+func (c *Condensation[T]) Component(index int) []T {
+	if index < 0 || index >= len(c.components) {
+		return nil
+	}
+
+	return c.components[index]
+}
+
+// ComponentOf returns the index of the component that contains the vertex
+// with the input label.
+//
+// It returns 'false' if the vertex doesn't exist.
+func (c *Condensation[T]) ComponentOf(label T) (int, bool) {
+	index, ok := c.componentOf[label]
+	return index, ok
+}
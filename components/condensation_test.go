@@ -0,0 +1,100 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package components
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestCondense(t *testing.T) {
+	// the strongly connected components {A B C} -> {D E} -> {F}, and {G} -> {D E}.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 1}, {"B", "C", 1}, {"C", "A", 1}, {"C", "D", 5}, {"B", "E", 3},
+		{"D", "E", 1}, {"E", "D", 1}, {"E", "F", 2}, {"G", "D", 4},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e.from), grafik.NewVertex(e.to), options.WithEdgeWeight(e.weight)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	c := Condense(g)
+
+	if len(c.Components()) != 4 || len(c.Grafik().GetAllVertices()) != 4 {
+		t.Fatalf("Expected 4 components, got %v", c.Components())
+	}
+
+	if !c.Grafik().IsDirected() {
+		t.Errorf("Expected directed condensation")
+	}
+
+	abc, _ := c.ComponentOf("A")
+	de, _ := c.ComponentOf("E")
+	f, _ := c.ComponentOf("F")
+	gIndex, _ := c.ComponentOf("G")
+
+	members := slices.Clone(c.Component(abc))
+	slices.Sort(members)
+	if !reflect.DeepEqual(members, []string{"A", "B", "C"}) {
+		t.Errorf("Expected component A B C, got %v", members)
+	}
+
+	if _, ok := c.ComponentOf("X"); ok {
+		t.Errorf("Expected no component for missing vertex")
+	}
+
+	if c.Component(-1) != nil || c.Component(4) != nil {
+		t.Errorf("Expected nil for out of range component")
+	}
+
+	condensed := c.Grafik()
+	vABC, vDE, vF, vG := condensed.GetVertexByLabel(abc), condensed.GetVertexByLabel(de), condensed.GetVertexByLabel(f), condensed.GetVertexByLabel(gIndex)
+
+	// the smallest of the edges C -> D (5) and B -> E (3).
+	if edge := condensed.GetEdge(vABC, vDE); edge == nil || edge.Weight() != 3 {
+		t.Errorf("Expected edge between A B C and D E of weight 3, got %v", edge)
+	}
+
+	if !condensed.ContainsEdge(vDE, vF) || !condensed.ContainsEdge(vG, vDE) {
+		t.Errorf("Expected edges D E -> F and G -> D E")
+	}
+
+	if condensed.ContainsEdge(vABC, vF) || condensed.ContainsEdge(vDE, vABC) {
+		t.Errorf("Expected no edges A B C -> F and D E -> A B C")
+	}
+
+	// the components are indexed in topological order.
+	for _, v := range condensed.GetAllVertices() {
+		for _, neighbor := range v.Neighbors() {
+			if v.Label() >= neighbor.Label() {
+				t.Errorf("Expected edge %d -> %d to go to a higher index", v.Label(), neighbor.Label())
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package components implements finding the groups of vertices that are
+// reachable from each other: connected components, strongly connected
+// components and the condensation of a directed graph.
+package components
+
+import (
+	"sort"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/iterator"
+)
+
+// Connected finds the labels of the vertices in each connected component.
+// In directed graph, it finds the weakly connected components, which
+// ignore the edge direction. The components are sorted from the largest one.
+func Connected[T comparable](g grafik.Grafik[T]) [][]T {
+	g = grafik.Snapshot(g)
+
+	visited := make(map[T]bool)
+	components := make([][]T, 0)
+
+	for _, v := range g.GetAllVertices() {
+		if visited[v.Label()] {
+			continue
+		}
+
+		var component []T
+		if g.IsDirected() {
+			component = weaklyConnected(v, visited)
+		} else {
+			component = connected(g, v.Label(), visited)
+		}
+
+		components = append(components, component)
+	}
+
+	sort.SliceStable(components, func(i, j int) bool {
+		return len(components[i]) > len(components[j])
+	})
+
+	return components
+}
+
+// connected finds the labels of the vertices reachable from the start
+// vertex of the undirected graph with the BFS iterator, and marks them as visited.
+func connected[T comparable](g grafik.Grafik[T], start T, visited map[T]bool) []T {
+	component := make([]T, 0)
+
+	it, _ := iterator.NewBreadthFirstIterator(g, start)
+	_ = it.Iterate(func(v *grafik.Vertex[T]) error {
+		visited[v.Label()] = true
+		component = append(component, v.Label())
+
+		return nil
+	})
+
+	return component
+}
+
+// weaklyConnected finds the labels of the vertices reachable from the start
+// vertex of the directed graph through edges in both directions, and marks
+// them as visited.
+func weaklyConnected[T comparable](start *grafik.Vertex[T], visited map[T]bool) []T {
+	visited[start.Label()] = true
+
+	fifo := []*grafik.Vertex[T]{start}
+	for head := 0; head < len(fifo); head++ {
+		for _, neighbors := range [][]*grafik.Vertex[T]{fifo[head].Neighbors(), fifo[head].InNeighbors()} {
+			for _, neighbor := range neighbors {
+				if !visited[neighbor.Label()] {
+					visited[neighbor.Label()] = true
+					fifo = append(fifo, neighbor)
+				}
+			}
+		}
+	}
+
+	component := make([]T, len(fifo))
+	for i, v := range fifo {
+		component[i] = v.Label()
+	}
+
+	return component
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package components
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// normalize sorts the labels in each component and then the components,
+// so components found in any order can be compared.
+func normalize(components [][]string) [][]string {
+	normalized := make([][]string, len(components))
+	for i, component := range components {
+		normalized[i] = slices.Clone(component)
+		slices.Sort(normalized[i])
+	}
+
+	slices.SortFunc(normalized, func(a, b []string) int {
+		return strings.Compare(strings.Join(a, ","), strings.Join(b, ","))
+	})
+
+	return normalized
+}
+
+func TestConnected(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	_ = g.AddVertexByLabel("F")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vC, vB)
+	_, _ = g.AddEdge(vD, vE)
+
+	components := Connected(g)
+
+	expected := [][]string{{"A", "B", "C"}, {"D", "E"}, {"F"}}
+	if !reflect.DeepEqual(normalize(components), expected) {
+		t.Errorf("Expected components %v, got %v", expected, components)
+	}
+
+	if len(components[0]) != 3 || len(components[2]) != 1 {
+		t.Errorf("Expected components sorted from the largest one, got %v", components)
+	}
+}
+
+func TestConnectedOfDirected(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_ = g.AddVertexByLabel("D")
+
+	// B and C can't reach each other, but they are weakly connected through A.
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vA, vC)
+
+	components := Connected(g)
+
+	expected := [][]string{{"A", "B", "C"}, {"D"}}
+	if !reflect.DeepEqual(normalize(components), expected) {
+		t.Errorf("Expected components %v, got %v", expected, components)
+	}
+}
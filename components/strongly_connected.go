@@ -0,0 +1,171 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package components
+
+import (
+	"slices"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/internal/dfs"
+)
+
+// Tarjan finds the labels of the vertices in each strongly connected
+// component using Tarjan's algorithm. In a strongly connected component,
+// every vertex can be reached from every other vertex following the edge
+// direction. In undirected graph, they are the connected components.
+//
+// The components are returned in reverse topological order: no component
+// has an edge to a component that comes after it.
+//
+// The time complexity of Tarjan's algorithm is O(V+E).
+func Tarjan[T comparable](g grafik.Grafik[T]) [][]T {
+	g = grafik.Snapshot(g)
+
+	index := make(map[T]int)
+	low := make(map[T]int)
+	onStack := make(map[T]bool)
+	stack := make([]T, 0)
+	components := make([][]T, 0)
+
+	for _, root := range g.GetAllVertices() {
+		if _, ok := index[root.Label()]; ok {
+			continue
+		}
+
+		// the depth-first search is iterative, so deep graphs can't overflow the call stack.
+		frames := []*dfs.Frame[T]{dfs.NewFrame(root)}
+		index[root.Label()], low[root.Label()] = len(index), len(index)
+		stack = append(stack, root.Label())
+		onStack[root.Label()] = true
+
+		for len(frames) > 0 {
+			frame := frames[len(frames)-1]
+			label := frame.Vertex().Label()
+
+			if neighbor, ok := frame.Next(); ok {
+				if _, ok := index[neighbor.Label()]; !ok {
+					index[neighbor.Label()], low[neighbor.Label()] = len(index), len(index)
+					stack = append(stack, neighbor.Label())
+					onStack[neighbor.Label()] = true
+					frames = append(frames, dfs.NewFrame(neighbor))
+				} else if onStack[neighbor.Label()] {
+					low[label] = min(low[label], index[neighbor.Label()])
+				}
+
+				continue
+			}
+
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].Vertex().Label()
+				low[parent] = min(low[parent], low[label])
+			}
+
+			// the vertex is the root of a component, which is on the stack above it.
+			if low[label] == index[label] {
+				i := len(stack) - 1
+				for stack[i] != label {
+					i--
+				}
+
+				component := slices.Clone(stack[i:])
+				for _, member := range component {
+					onStack[member] = false
+				}
+
+				stack = stack[:i]
+				components = append(components, component)
+			}
+		}
+	}
+
+	return components
+}
+
+// Kosaraju finds the labels of the vertices in each strongly connected
+// component using Kosaraju's algorithm. It runs a depth-first search to
+// order the vertices by their finish time, and then collects the vertices
+// reachable through the reversed edges in the reverse of that order.
+// In undirected graph, they are the connected components.
+//
+// The components are returned in topological order: no component has an
+// edge to a component that comes before it.
+//
+// The time complexity of Kosaraju's algorithm is O(V+E).
+func Kosaraju[T comparable](g grafik.Grafik[T]) [][]T {
+	g = grafik.Snapshot(g)
+
+	visited := make(map[T]bool)
+	finished := make([]*grafik.Vertex[T], 0)
+
+	for _, root := range g.GetAllVertices() {
+		if visited[root.Label()] {
+			continue
+		}
+
+		visited[root.Label()] = true
+		frames := []*dfs.Frame[T]{dfs.NewFrame(root)}
+		for len(frames) > 0 {
+			frame := frames[len(frames)-1]
+			if neighbor, ok := frame.Next(); ok {
+				if !visited[neighbor.Label()] {
+					visited[neighbor.Label()] = true
+					frames = append(frames, dfs.NewFrame(neighbor))
+				}
+
+				continue
+			}
+
+			frames = frames[:len(frames)-1]
+			finished = append(finished, frame.Vertex())
+		}
+	}
+
+	assigned := make(map[T]bool)
+	components := make([][]T, 0)
+
+	for i := len(finished) - 1; i >= 0; i-- {
+		root := finished[i]
+		if assigned[root.Label()] {
+			continue
+		}
+
+		assigned[root.Label()] = true
+		component := []T{root.Label()}
+		pending := []*grafik.Vertex[T]{root}
+		for len(pending) > 0 {
+			v := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+
+			for _, neighbor := range v.InNeighbors() {
+				if !assigned[neighbor.Label()] {
+					assigned[neighbor.Label()] = true
+					component = append(component, neighbor.Label())
+					pending = append(pending, neighbor)
+				}
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	return components
+}
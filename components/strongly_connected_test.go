@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package components
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestStronglyConnected(t *testing.T) {
+	// the strongly connected components {A B C} -> {D E} -> {F}, and {G} -> {D E}.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 1}, {"B", "C", 1}, {"C", "A", 1}, {"C", "D", 5}, {"B", "E", 3},
+		{"D", "E", 1}, {"E", "D", 1}, {"E", "F", 2}, {"G", "D", 4},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e.from), grafik.NewVertex(e.to), options.WithEdgeWeight(e.weight)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+	expected := [][]string{{"A", "B", "C"}, {"D", "E"}, {"F"}, {"G"}}
+
+	for name, algorithm := range map[string]func(grafik.Grafik[string]) [][]string{"Tarjan": Tarjan[string], "Kosaraju": Kosaraju[string]} {
+		components := algorithm(g)
+		if !reflect.DeepEqual(normalize(components), expected) {
+			t.Errorf("Expected %s components %v, got %v", name, expected, components)
+		}
+	}
+}
+
+func TestStronglyConnectedOrder(t *testing.T) {
+	// the strongly connected components {A B C} -> {D E} -> {F}, and {G} -> {D E}.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{
+		{"A", "B", 1}, {"B", "C", 1}, {"C", "A", 1}, {"C", "D", 5}, {"B", "E", 3},
+		{"D", "E", 1}, {"E", "D", 1}, {"E", "F", 2}, {"G", "D", 4},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e.from), grafik.NewVertex(e.to), options.WithEdgeWeight(e.weight)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	tarjan := Tarjan(g)
+	kosaraju := Kosaraju(g)
+
+	position := func(components [][]string) map[string]int {
+		positions := make(map[string]int)
+		for i, component := range components {
+			for _, label := range component {
+				positions[label] = i
+			}
+		}
+
+		return positions
+	}
+
+	tarjanPositions, kosarajuPositions := position(tarjan), position(kosaraju)
+	for _, v := range g.GetAllVertices() {
+		for _, neighbor := range v.Neighbors() {
+			from, to := v.Label(), neighbor.Label()
+			if tarjanPositions[from] < tarjanPositions[to] {
+				t.Errorf("Expected Tarjan components in reverse topological order, got %v", tarjan)
+			}
+
+			if kosarajuPositions[from] > kosarajuPositions[to] {
+				t.Errorf("Expected Kosaraju components in topological order, got %v", kosaraju)
+			}
+		}
+	}
+}
+
+func TestStronglyConnectedRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	g := grafik.New[string](options.WithDirected())
+	for i := 0; i < 100; i++ {
+		g.AddVertexByLabel(fmt.Sprint(i))
+	}
+
+	for i := 0; i < 150; i++ {
+		from, to := g.GetVertexByLabel(fmt.Sprint(rnd.Intn(100))), g.GetVertexByLabel(fmt.Sprint(rnd.Intn(100)))
+		if from != to {
+			_, _ = g.AddEdge(from, to)
+		}
+	}
+
+	if tarjan, kosaraju := normalize(Tarjan(g)), normalize(Kosaraju(g)); !reflect.DeepEqual(tarjan, kosaraju) {
+		t.Errorf("Expected the same components, got %v and %v", tarjan, kosaraju)
+	}
+}
+
+func TestStronglyConnectedOfUndirected(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	_ = g.AddVertexByLabel("C")
+
+	if _, err := g.AddEdge(vA, vB); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := normalize(Connected(g))
+	if components := normalize(Tarjan(g)); !reflect.DeepEqual(components, expected) {
+		t.Errorf("Expected Tarjan components %v, got %v", expected, components)
+	}
+
+	if components := normalize(Kosaraju(g)); !reflect.DeepEqual(components, expected) {
+		t.Errorf("Expected Kosaraju components %v, got %v", expected, components)
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grafik
+
+import (
+	"sync"
+
+	"github.com/fitm-elite/grafik/options"
+)
+
+// concurrentGrafik is a concurrency-safe implementation of the Grafik
+// interface. It guards the underlying grafik with a read/write lock that
+// is also shared with its vertices, so the neighbor slices and degree
+// counters can be read while other goroutines are modifying the graph.
+type concurrentGrafik[T comparable] struct {
+	mu     sync.RWMutex
+	grafik *grafik[T]
+}
+
+// NewConcurrent creates a new graph that is safe for concurrent use by
+// multiple goroutines. It accepts the same options as New.
+//
+// Every method call is atomic. The algorithms, iterators and encoders of
+// this module run on a Snapshot of the graph, so they don't observe the
+// changes that other goroutines make while they are running. Each of them
+// copies the graph once, in O(V+E) time and memory.
+func NewConcurrent[T comparable](opts ...options.GrafikOptionFunc) Grafik[T] {
+	c := &concurrentGrafik[T]{grafik: newGrafik[T](opts...)}
+	c.grafik.mu = &c.mu
+
+	return c
+}
+
+// Snapshot returns a copy of the concurrent graph that is taken under its
+// read lock, so an algorithm that makes several calls sees the graph as it
+// was at a single point in time. The copy has its own vertices and edges,
+// and later changes of the input graph don't affect it.
+//
+// The vertices and edges in the results of the algorithms that run on a
+// snapshot belong to the copy, not to the input graph. Look them up in the
+// input graph by their labels, or the edges by their ids with GetEdgeByID.
+//
+// Other graphs are returned as they are.
+func Snapshot[T comparable](g Grafik[T]) Grafik[T] {
+	c, ok := g.(*concurrentGrafik[T])
+	if !ok {
+		return g
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.clone()
+}
+
+// IsDirected returns 'true' if the graph was created in directed mode.
+func (c *concurrentGrafik[T]) IsDirected() bool {
+	return c.grafik.IsDirected()
+}
+
+// IsMultigraph returns 'true' if the graph was created in multigraph mode.
+func (c *concurrentGrafik[T]) IsMultigraph() bool {
+	return c.grafik.IsMultigraph()
+}
+
+// IsSelfLoopsRejected returns 'true' if the graph was created to reject self-loops.
+func (c *concurrentGrafik[T]) IsSelfLoopsRejected() bool {
+	return c.grafik.IsSelfLoopsRejected()
+}
+
+//
+// Vertex implementations
+//
+
+// AddVertexByLabel adds a new vertex with the given label to the graph.
+// Label of the vertex is a comparable type. This method also accepts the
+// vertex properties such as weight.
+//
+// If there is a vertex with the same label in the graph, returns nil.
+// Otherwise, returns the created vertex.
+func (c *concurrentGrafik[T]) AddVertexByLabel(label T, opts ...options.VertexOptionFunc) *Vertex[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.grafik.AddVertexByLabel(label, opts...)
+}
+
+// AddVertex adds the input vertex to the graph. It doesn't add
+// vertex to the graph if the input vertex label is already exists
+// in the graph.
+func (c *concurrentGrafik[T]) AddVertex(v *Vertex[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.grafik.AddVertex(v)
+}
+
+// GetVertexByID returns the vertex with the input label.
+//
+// If vertex doesn't exist, returns nil.
+func (c *concurrentGrafik[T]) GetVertexByLabel(label T) *Vertex[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.GetVertexByLabel(label)
+}
+
+// GetAllVertices returns a slice of all existing vertices in the graph.
+func (c *concurrentGrafik[T]) GetAllVertices() []*Vertex[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.GetAllVertices()
+}
+
+// ContainsVertex returns 'true' if this graph contains the specified vertex.
+//
+// If the specified vertex is nil, returns 'false'.
+func (c *concurrentGrafik[T]) ContainsVertex(v *Vertex[T]) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.ContainsVertex(v)
+}
+
+// RemoveVertexByLabel removes the vertex with the input label from the
+// graph, together with all edges going from or to that vertex.
+//
+// If vertex doesn't exist, returns ErrVertexDoesNotExist.
+func (c *concurrentGrafik[T]) RemoveVertexByLabel(label T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.grafik.RemoveVertexByLabel(label)
+}
+
+// RemoveVertex removes the input vertex from the graph, together with
+// all edges going from or to that vertex.
+//
+// If the specified vertex is nil, returns ErrNilVertices.
+// If vertex doesn't exist, returns ErrVertexDoesNotExist.
+func (c *concurrentGrafik[T]) RemoveVertex(v *Vertex[T]) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.grafik.RemoveVertex(v)
+}
+
+//
+// Edge implementations
+//
+
+// AddEdge adds and edge from the vertex with the 'from' label to
+// the vertex with the 'to' label by appending the 'to' vertex to the
+// 'neighbors' slice of the 'from' vertex, in directed graph.
+//
+// In undirected graph, it creates edges in both directions between
+// the specified vertices.
+//
+// It creates the input vertices if they don't exist in the graph.
+// If any of the specified vertices is nil, returns nil.
+// If edge already exist, returns error, unless the graph is a multigraph.
+// In multigraph, the edge is added next to the existing ones.
+// If the vertices are the same and self-loops are rejected, returns ErrSelfLoop.
+func (c *concurrentGrafik[T]) AddEdge(from, to *Vertex[T], opts ...options.EdgeOptionFunc) (*Edge[T], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.grafik.AddEdge(from, to, opts...)
+}
+
+// GetAllEdges returns a slice of all edges connecting source vertex to
+// target vertex if such vertices exist in this graph.
+//
+// In directed graph, it returns a single edge, or all parallel edges
+// in multigraph. In undirected graph, it returns the edges in both
+// directions.
+//
+// If any of the specified vertices is nil, returns nil.
+// If any of the vertices does not exist, returns nil.
+// If both vertices exist but no edges found, returns an empty set.
+func (c *concurrentGrafik[T]) GetAllEdges(from, to *Vertex[T]) []*Edge[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.GetAllEdges(from, to)
+}
+
+// GetEdge returns an edge connecting source vertex to target vertex
+// if such vertices and such edge exist in this graph.
+//
+// In undirected graph, returns only the edge from the "from" vertex to
+// the "to" vertex. In multigraph, returns the parallel edge with the
+// lowest weight.
+//
+// If any of the specified vertices is nil, returns nil.
+// If edge does not exist, returns nil.
+func (c *concurrentGrafik[T]) GetEdge(from, to *Vertex[T]) *Edge[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.GetEdge(from, to)
+}
+
+// ContainsEdge returns 'true' if and only if this graph contains an edge
+// going from the source vertex to the target vertex.
+//
+// If any of the specified vertices does not exist in the graph, or if is nil,
+// returns 'false'.
+func (c *concurrentGrafik[T]) ContainsEdge(from, to *Vertex[T]) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.ContainsEdge(from, to)
+}
+
+// RemoveEdge removes the edge going from the source vertex to the target
+// vertex, in directed graph.
+//
+// In undirected graph, it removes the edges in both directions between
+// the specified vertices. In multigraph, it removes all parallel edges.
+// Use RemoveEdgeByID to remove one of the parallel edges.
+//
+// If any of the specified vertices is nil, returns ErrNilVertices.
+// If any of the vertices does not exist, returns ErrVertexDoesNotExist.
+// If edge does not exist, returns ErrEdgeDoesNotExist.
+func (c *concurrentGrafik[T]) RemoveEdge(from, to *Vertex[T]) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.grafik.RemoveEdge(from, to)
+}
+
+// GetEdgeByID returns the edge with the input id, in the direction it
+// was added, so the parallel edges of a multigraph can be told apart.
+//
+// If edge does not exist, returns nil.
+func (c *concurrentGrafik[T]) GetEdgeByID(id int) *Edge[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.grafik.GetEdgeByID(id)
+}
+
+// RemoveEdgeByID removes the edge with the input id, in both directions
+// in undirected graph. The parallel edges between the same vertices are
+// kept, and the vertices stay neighbors until the last of them is removed.
+//
+// If edge does not exist, returns ErrEdgeDoesNotExist.
+func (c *concurrentGrafik[T]) RemoveEdgeByID(id int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.grafik.RemoveEdgeByID(id)
+}
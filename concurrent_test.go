@@ -0,0 +1,192 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package grafik
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestConcurrentGrafik(t *testing.T) {
+	for _, g := range []Grafik[int]{NewConcurrent[int](), NewConcurrent[int](options.WithDirected())} {
+		const n = 50
+
+		var wg sync.WaitGroup
+
+		// writers
+		for w := 0; w < 4; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := w; i < n; i += 4 {
+					_ = g.AddVertexByLabel(i)
+					if i > 0 {
+						// the edge creates the missing vertex, if other writer hasn't added it yet.
+						_, _ = g.AddEdge(NewVertex(i-1), NewVertex(i), options.WithEdgeWeight(float64(i)))
+					}
+				}
+			}(w)
+		}
+
+		// readers
+		for r := 0; r < 4; r++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < n; i++ {
+					for _, v := range g.GetAllVertices() {
+						_ = v.Degree()
+						_ = v.HasNeighbor(NewVertex(i))
+						for _, neighbor := range v.Neighbors() {
+							_ = neighbor.InDegree()
+							_ = g.ContainsEdge(v, neighbor)
+							_ = g.GetAllEdges(v, neighbor)
+						}
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if len(g.GetAllVertices()) != n {
+			t.Errorf(testErrMsgWrongLen, n, len(g.GetAllVertices()))
+		}
+
+		for i := 1; i < n; i++ {
+			if !g.ContainsEdge(NewVertex(i-1), NewVertex(i)) {
+				t.Errorf("Expected edge %d -> %d, but got nothing", i-1, i)
+			}
+		}
+
+		// remove every other vertex while reading the rest.
+		for i := 0; i < n; i += 2 {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				if err := g.RemoveVertexByLabel(i); err != nil {
+					t.Errorf(testErrMsgError, err)
+				}
+			}(i)
+			go func(i int) {
+				defer wg.Done()
+				if v := g.GetVertexByLabel(i + 1); v != nil {
+					_ = v.OutDegree()
+					_ = g.GetEdge(v, NewVertex(i+2))
+				}
+			}(i)
+		}
+
+		wg.Wait()
+
+		for _, v := range g.GetAllVertices() {
+			if v.Degree() != 0 {
+				t.Errorf(testErrMsgNotEqual, 0, fmt.Sprintf("%d (vertex %d)", v.Degree(), v.Label()))
+			}
+		}
+	}
+}
+
+func TestConcurrentGrafikRemoveEdge(t *testing.T) {
+	g := NewConcurrent[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	_, err := g.AddEdge(vA, vB)
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if g.GetEdge(vA, vB) == nil {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if err = g.RemoveEdge(vA, vB); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if g.ContainsEdge(vB, vA) || !g.ContainsVertex(vA) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	g.AddVertex(NewVertex("C"))
+	if err = g.RemoveVertex(g.GetVertexByLabel("C")); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	edge, err := g.AddEdge(vA, vB)
+	if err != nil || g.GetEdgeByID(edge.ID()) != edge {
+		t.Errorf("Expected edge %v by its id, but got %v", edge, err)
+	}
+
+	if err = g.RemoveEdgeByID(edge.ID()); err != nil || g.ContainsEdge(vA, vB) {
+		t.Errorf(testErrMsgError, err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	g := New[string]()
+	if Snapshot(g) != g {
+		t.Error("Expected the graph that isn't concurrent to be returned as it is")
+	}
+
+	c := NewConcurrent[string](options.WithDirected())
+	vA := c.AddVertexByLabel("A")
+	vB := c.AddVertexByLabel("B")
+
+	edge, err := c.AddEdge(vA, vB, options.WithEdgeWeight(3), options.WithEdgeAttr("road", "A1"))
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	snapshot := Snapshot(c)
+	if !snapshot.IsDirected() || len(snapshot.GetAllVertices()) != 2 {
+		t.Errorf(testErrMsgNotEqual, 2, len(snapshot.GetAllVertices()))
+	}
+
+	copied := snapshot.GetEdge(vA, vB)
+	if copied == nil || copied == edge || copied.ID() != edge.ID() || copied.Weight() != 3 {
+		t.Errorf("Expected a copy of edge %v, but got %v", edge, copied)
+	}
+
+	if road, _ := EdgeAttrAs[string](copied, "road"); road != "A1" {
+		t.Errorf(testErrMsgNotEqual, "A1", road)
+	}
+
+	// the changes of the concurrent graph don't affect the snapshot.
+	c.AddVertexByLabel("C")
+	if err = c.RemoveEdge(vA, vB); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	a := snapshot.GetVertexByLabel("A")
+	if len(snapshot.GetAllVertices()) != 2 || a.OutDegree() != 1 || a.Neighbors()[0] != snapshot.GetVertexByLabel("B") {
+		t.Errorf("Expected the snapshot to keep A -> B, but got %v", a.Neighbors())
+	}
+
+	if snapshot.GetVertexByLabel("B").InDegree() != 1 || vB.InDegree() != 0 {
+		t.Errorf("Expected in degrees 1 and 0, but got %d and %d", snapshot.GetVertexByLabel("B").InDegree(), vB.InDegree())
+	}
+}
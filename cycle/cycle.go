@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package cycle implements detecting and finding cycles in directed and
+// undirected graphs.
+package cycle
+
+import (
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/internal/dfs"
+)
+
+// color represents the state of a vertex in a depth-first search.
+type color int
+
+const (
+	white color = iota // not visited yet.
+	gray               // visited, and its descendants are being visited.
+	black              // visited together with all its descendants.
+)
+
+// HasCycle returns 'true' if the graph contains a cycle.
+//
+// In directed graph, a cycle follows the edge direction. In both kinds of
+// graph, a self-loop is a cycle of one vertex. In undirected graph, going
+// back along the same edge isn't a cycle, so a single edge between two
+// vertices doesn't form one, but two parallel edges of a multigraph do.
+func HasCycle[T comparable](g grafik.Grafik[T]) bool {
+	_, ok := FindCycle(g)
+	return ok
+}
+
+// FindCycle returns the labels of the vertices on a cycle of the graph, in
+// the order of the edges. The first vertex is not repeated at the end.
+//
+// It returns 'false' if the graph has no cycle.
+func FindCycle[T comparable](g grafik.Grafik[T]) ([]T, bool) {
+	g = grafik.Snapshot(g)
+
+	colors := make(map[T]color)
+
+	for _, root := range g.GetAllVertices() {
+		if colors[root.Label()] != white {
+			continue
+		}
+
+		if cycle := findCycleFrom(g, root, colors); cycle != nil {
+			return cycle, true
+		}
+	}
+
+	return nil, false
+}
+
+// findCycleFrom runs a depth-first search from the root vertex, and
+// returns the first cycle found. The vertices on the search stack are gray,
+// so an edge to a gray vertex closes a cycle.
+func findCycleFrom[T comparable](g grafik.Grafik[T], root *grafik.Vertex[T], colors map[T]color) []T {
+	colors[root.Label()] = gray
+	frames := []*dfs.Frame[T]{dfs.NewFrame(root)}
+
+	for len(frames) > 0 {
+		frame := frames[len(frames)-1]
+		neighbor, ok := frame.Next()
+		if !ok {
+			colors[frame.Vertex().Label()] = black
+			frames = frames[:len(frames)-1]
+
+			continue
+		}
+
+		// in undirected graph, the edge back to the parent is the same edge,
+		// unless a parallel edge connects them.
+		if !g.IsDirected() && len(frames) > 1 && frames[len(frames)-2].Vertex().Label() == neighbor.Label() &&
+			!hasParallelEdges(g, frame.Vertex(), neighbor) {
+			continue
+		}
+
+		switch colors[neighbor.Label()] {
+		case white:
+			colors[neighbor.Label()] = gray
+			frames = append(frames, dfs.NewFrame(neighbor))
+		case gray:
+			return dfs.CycleOnStack(frames, neighbor.Label())
+		}
+	}
+
+	return nil
+}
+
+// hasParallelEdges reports whether more than one edge connects the vertices.
+// Both directions of an undirected edge share its id, so the ids are counted.
+func hasParallelEdges[T comparable](g grafik.Grafik[T], from, to *grafik.Vertex[T]) bool {
+	if !g.IsMultigraph() {
+		return false
+	}
+
+	ids := make(map[int]bool)
+	for _, edge := range g.GetAllEdges(from, to) {
+		ids[edge.ID()] = true
+	}
+
+	return len(ids) > 1
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cycle
+
+import (
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// checkCycle reports an error if the labels don't form a cycle of the graph.
+func checkCycle[T comparable](t *testing.T, g grafik.Grafik[T], cycle []T, size int) {
+	t.Helper()
+
+	if len(cycle) != size {
+		t.Fatalf("Expected a cycle of %d vertices, got %v", size, cycle)
+	}
+
+	for i, label := range cycle {
+		from := g.GetVertexByLabel(label)
+		to := g.GetVertexByLabel(cycle[(i+1)%len(cycle)])
+		if !g.ContainsEdge(from, to) {
+			t.Errorf("Expected edge %v -> %v in cycle %v", label, cycle[(i+1)%len(cycle)], cycle)
+		}
+	}
+}
+
+func TestFindCycleOfDirected(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vA, vC)
+	_, _ = g.AddEdge(vB, vD)
+	_, _ = g.AddEdge(vC, vD)
+
+	// A -> B -> D and A -> C -> D meet, but don't form a cycle.
+	if c, ok := FindCycle(g); ok || HasCycle(g) {
+		t.Errorf("Expected no cycle, got %v", c)
+	}
+
+	_, _ = g.AddEdge(vD, vA)
+
+	c, ok := FindCycle(g)
+	if !ok || !HasCycle(g) {
+		t.Fatalf("Expected a cycle, got nothing")
+	}
+
+	checkCycle(t, g, c, 3)
+}
+
+func TestFindCycleSelfLoop(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+
+		_, _ = g.AddEdge(vA, vB)
+		_, _ = g.AddEdge(vB, vB)
+
+		// a self-loop is a cycle of one vertex.
+		c, ok := FindCycle(g)
+		if !ok {
+			t.Fatalf("Expected a cycle, got nothing")
+		}
+
+		checkCycle(t, g, c, 1)
+	}
+
+	g := grafik.New[string](options.WithSelfLoopsRejected())
+	vA := g.AddVertexByLabel("A")
+	if _, err := g.AddEdge(vA, vA); err == nil || HasCycle(g) {
+		t.Errorf("Expected the self-loop to be rejected")
+	}
+}
+
+func TestFindCycleOfUndirected(t *testing.T) {
+	g := grafik.New[int]()
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	v4 := g.AddVertexByLabel(4)
+
+	_, _ = g.AddEdge(v1, v2)
+	_, _ = g.AddEdge(v2, v3)
+	_, _ = g.AddEdge(v2, v4)
+
+	// a tree has no cycle, even though every edge can be walked both ways.
+	if c, ok := FindCycle(g); ok || HasCycle(g) {
+		t.Errorf("Expected no cycle, got %v", c)
+	}
+
+	_, _ = g.AddEdge(v3, v4)
+
+	c, ok := FindCycle(g)
+	if !ok {
+		t.Fatalf("Expected a cycle, got nothing")
+	}
+
+	checkCycle(t, g, c, 3)
+
+	if HasCycle(grafik.New[int]()) {
+		t.Errorf("Expected no cycle in empty graph")
+	}
+}
+
+func TestFindCycleOfMultigraph(t *testing.T) {
+	g := grafik.New[string](options.WithMultigraph())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vB, vC)
+	if c, ok := FindCycle(g); ok {
+		t.Errorf("Expected no cycle, got %v", c)
+	}
+
+	// two distinct edges between B and C form a cycle of two vertices.
+	parallel, _ := g.AddEdge(vC, vB)
+
+	c, ok := FindCycle(g)
+	if !ok || !HasCycle(g) {
+		t.Fatalf("Expected a cycle, got nothing")
+	}
+
+	checkCycle(t, g, c, 2)
+
+	if err := g.RemoveEdgeByID(parallel.ID()); err != nil || HasCycle(g) {
+		t.Errorf("Expected no cycle after removing the parallel edge, got %v", err)
+	}
+}
@@ -24,6 +24,7 @@ import "github.com/fitm-elite/grafik/options"
 
 // Edge represents an edges in a graph. It contains start and end points.
 type Edge[T comparable] struct {
+	id     int        // id given by the graph, shared by both directions of an undirected edge
 	source *Vertex[T] // start point of the edges
 	dest   *Vertex[T] // destination or end point of the edges
 
@@ -42,6 +43,14 @@ func NewEdge[T comparable](source *Vertex[T], dest *Vertex[T], opts ...options.E
 	return e
 }
 
+// ID returns the id of the edge. The graph gives each added edge its own
+// id, and both directions of an undirected edge share the same id, so it
+// tells the parallel edges of a multigraph apart. An edge that is created
+// with NewEdge has the id 0.
+func (e Edge[T]) ID() int {
+	return e.id
+}
+
 // Source returns edge source vertex
 func (e Edge[T]) Source() *Vertex[T] {
 	return e.source
@@ -56,3 +65,21 @@ func (e Edge[T]) Destination() *Vertex[T] {
 func (e *Edge[T]) Weight() float64 {
 	return e.properties.Weight()
 }
+
+// Attr returns the edge attribute with the input key.
+// It returns 'false' if the attribute doesn't exist.
+func (e *Edge[T]) Attr(key string) (any, bool) {
+	return e.properties.Attr(key)
+}
+
+// Attrs returns a copy of all edge attributes.
+func (e *Edge[T]) Attrs() map[string]any {
+	return e.properties.Attrs()
+}
+
+// EdgeAttrAs returns the attribute of the input edge with the input key
+// as type A. It returns 'false' if the attribute doesn't exist, or if it
+// has a different type.
+func EdgeAttrAs[A any, T comparable](e *Edge[T], key string) (A, bool) {
+	return attrAs[A](e.Attr(key))
+}
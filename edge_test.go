@@ -50,6 +50,25 @@ func TestEdgeDest(t *testing.T) {
 	}
 }
 
+func TestEdgeID(t *testing.T) {
+	g := New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	eAB, _ := g.AddEdge(vA, vB)
+	eBC, _ := g.AddEdge(vB, vC)
+
+	if eAB.ID() == 0 || eAB.ID() == eBC.ID() {
+		t.Errorf("Expected different non-zero ids, but got %d and %d", eAB.ID(), eBC.ID())
+	}
+
+	if id := NewEdge(vA, vB).ID(); id != 0 {
+		t.Errorf(testErrMsgNotEqual, 0, id)
+	}
+}
+
 func TestEdgeWeight(t *testing.T) {
 	weight := 4.00
 
@@ -63,3 +82,31 @@ func TestEdgeWeight(t *testing.T) {
 		t.Errorf(testErrMsgNotEqual, weight, eWeight)
 	}
 }
+
+func TestEdgeAttr(t *testing.T) {
+	g := New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	_, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2), options.WithEdgeAttr("capacity", 10.5))
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	// both directions of undirected edge have the attribute.
+	for _, e := range g.GetAllEdges(vA, vB) {
+		capacity, ok := EdgeAttrAs[float64](e, "capacity")
+		if !ok || capacity != 10.5 {
+			t.Errorf(testErrMsgNotEqual, 10.5, capacity)
+		}
+
+		if len(e.Attrs()) != 1 {
+			t.Errorf(testErrMsgWrongLen, 1, len(e.Attrs()))
+		}
+	}
+
+	if _, ok := NewEdge(vA, vB).Attr("capacity"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+}
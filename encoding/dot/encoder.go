@@ -0,0 +1,198 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dot implements writing graphs in the Graphviz DOT language, so they
+// can be rendered with tools such as "dot -Tsvg", and parsing the common
+// subset of the language back into graphs.
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+const (
+	// weightAttr isn't the Graphviz "weight" attribute, which is a layout
+	// hint that must be a non-negative integer.
+	weightAttr = "grafik_weight"
+	labelAttr  = "label"
+)
+
+// Encoder writes graphs in the DOT language to an output stream.
+type Encoder[T comparable] struct {
+	w          io.Writer
+	properties options.DotProperties[T]
+}
+
+// NewEncoder returns a new encoder that writes to w. Use the dot options
+// such as options.WithDotHighlightPath to highlight a part of the graph.
+func NewEncoder[T comparable](w io.Writer, opts ...options.DotOptionFunc[T]) *Encoder[T] {
+	e := &Encoder[T]{w: w}
+	for _, opt := range opts {
+		opt(&e.properties)
+	}
+
+	return e
+}
+
+// Encode writes the graph in the DOT language to the stream. The vertex
+// ids are the labels formatted with fmt.Sprint. The weights are written
+// as "grafik_weight" attributes, and the edge weights are also used as the
+// edge labels. The vertex and edge attributes are written as DOT attributes.
+//
+// In undirected graph, each edge is written once. In multigraph, each
+// parallel edge is written as its own edge statement.
+func (e *Encoder[T]) Encode(g grafik.Grafik[T]) error {
+	g = grafik.Snapshot(g)
+
+	// the writer keeps the first error, and Flush returns it, so the
+	// results of the writes below are ignored.
+	w := bufio.NewWriter(e.w)
+
+	kind, op := "graph", "--"
+	if g.IsDirected() {
+		kind, op = "digraph", "->"
+	}
+
+	vertices := g.GetAllVertices()
+	sortVertices(vertices)
+
+	_, _ = fmt.Fprintf(w, "%s {\n", kind)
+
+	for _, v := range vertices {
+		attrs := formatAttrs(v.Attrs(), v.Weight())
+		if e.properties.IsHighlightedVertex(v.Label()) {
+			attrs = append(attrs, e.highlightAttrs()...)
+		}
+
+		_, _ = fmt.Fprintf(w, "  %s%s;\n", quote(fmt.Sprint(v.Label())), joinAttrs(attrs))
+	}
+
+	written := make(map[int]bool)
+	for _, v := range vertices {
+		// the neighbor slice belongs to the vertex, so it is sorted on a copy.
+		neighbors := slices.Clone(v.Neighbors())
+		sortVertices(neighbors)
+
+		for _, neighbor := range neighbors {
+			for _, edge := range g.GetAllEdges(v, neighbor) {
+				// skip the opposite direction of an already written undirected edge.
+				if edge.Source().Label() != v.Label() || written[edge.ID()] {
+					continue
+				}
+
+				attrs := formatAttrs(edge.Attrs(), edge.Weight())
+				if _, ok := edge.Attr(labelAttr); !ok && edge.Weight() != 0 {
+					attrs = append(attrs, labelAttr+"="+quote(formatWeight(edge.Weight())))
+				}
+
+				if e.isHighlightedEdge(g, v.Label(), neighbor.Label()) {
+					attrs = append(attrs, e.highlightAttrs()...)
+				}
+
+				_, _ = fmt.Fprintf(w, "  %s %s %s%s;\n", quote(fmt.Sprint(v.Label())), op, quote(fmt.Sprint(neighbor.Label())), joinAttrs(attrs))
+				written[edge.ID()] = true
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintln(w, "}")
+
+	return w.Flush()
+}
+
+// isHighlightedEdge reports whether the edge is highlighted, in both
+// directions if the graph is undirected.
+func (e *Encoder[T]) isHighlightedEdge(g grafik.Grafik[T], from, to T) bool {
+	if e.properties.IsHighlightedEdge(from, to) {
+		return true
+	}
+
+	return !g.IsDirected() && e.properties.IsHighlightedEdge(to, from)
+}
+
+// highlightAttrs returns the DOT attributes of the highlighted vertices and edges.
+func (e *Encoder[T]) highlightAttrs() []string {
+	return []string{"color=" + quote(e.properties.GetHighlightColor()), "penwidth=2"}
+}
+
+// sortVertices sorts the vertices by their formatted labels, so the output
+// is stable.
+func sortVertices[T comparable](vertices []*grafik.Vertex[T]) {
+	sort.Slice(vertices, func(i, j int) bool {
+		return fmt.Sprint(vertices[i].Label()) < fmt.Sprint(vertices[j].Label())
+	})
+}
+
+// formatAttrs returns the DOT attributes of the attributes sorted by name,
+// followed by the weight if it isn't zero.
+func formatAttrs(attrs map[string]any, weight float64) []string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		if name != weightAttr {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	formatted := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		formatted = append(formatted, quote(name)+"="+quote(fmt.Sprint(attrs[name])))
+	}
+
+	if weight != 0 {
+		formatted = append(formatted, weightAttr+"="+quote(formatWeight(weight)))
+	}
+
+	return formatted
+}
+
+// joinAttrs returns the DOT attribute list, or nothing if there are no attributes.
+func joinAttrs(attrs []string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(attrs, ", ") + "]"
+}
+
+// formatWeight returns the shortest form of the weight.
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'g', -1, 64)
+}
+
+// quote returns the DOT quoted string of s. The backslashes are escaped
+// first, so the ones added before the quotes aren't escaped again.
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+
+	return `"` + s + `"`
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dot
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestEncode(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("shape", "box"))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3), options.WithEdgeAttr("style", "dashed")); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vA, vC); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder[string](&buf).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `digraph {
+  "A" ["shape"="box", grafik_weight="1.5"];
+  "B";
+  "C";
+  "A" -> "B" [grafik_weight="2", label="2"];
+  "A" -> "C";
+  "B" -> "C" ["style"="dashed", grafik_weight="3", label="3"];
+}
+`
+	if buf.String() != expected {
+		t.Errorf("Expected %s, but got %s", expected, buf.String())
+	}
+}
+
+func TestEncodeUndirected(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("shape", "box"))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3), options.WithEdgeAttr("style", "dashed")); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vA, vC); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder[string](&buf).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "graph {") {
+		t.Errorf("Expected graph keyword, but got %s", buf.String())
+	}
+
+	if count := strings.Count(buf.String(), " -- "); count != 3 {
+		t.Errorf("Expected %d edges, but got %d", 3, count)
+	}
+}
+
+func TestEncodeMultigraph(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{{options.WithMultigraph()}, {options.WithMultigraph(), options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("shape", "box"))
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C")
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3), options.WithEdgeAttr("style", "dashed")); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vA, vC); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(5)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		var buf bytes.Buffer
+		if err := NewEncoder[string](&buf).Encode(g); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		// each parallel edge is written once.
+		if count := strings.Count(buf.String(), `"A" -`); count != 3 {
+			t.Errorf("Expected %d edges from A, but got %d in %s", 3, count, buf.String())
+		}
+
+		if !strings.Contains(buf.String(), `grafik_weight="5"`) || !strings.Contains(buf.String(), `grafik_weight="2"`) {
+			t.Errorf("Expected both parallel edges, but got %s", buf.String())
+		}
+	}
+}
+
+func TestEncodeKeepsNeighborOrder(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	for _, label := range []string{"D", "C", "B"} {
+		if _, err := g.AddEdge(vA, g.AddVertexByLabel(label)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	before := labelsOf(vA.Neighbors())
+	if err := NewEncoder[string](&bytes.Buffer{}).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if after := labelsOf(vA.Neighbors()); !reflect.DeepEqual(before, after) {
+		t.Errorf("Expected neighbors %v to be left in order, but got %v", before, after)
+	}
+}
+
+// labelsOf returns the labels of the vertices.
+func labelsOf(vertices []*grafik.Vertex[string]) []string {
+	labels := make([]string, 0, len(vertices))
+	for _, v := range vertices {
+		labels = append(labels, v.Label())
+	}
+
+	return labels
+}
+
+func TestEncodeHighlight(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("shape", "box"))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3), options.WithEdgeAttr("style", "dashed")); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vA, vC); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, options.WithDotHighlightPath("C", "B"), options.WithDotHighlightColor[string]("blue"))
+	if err := enc.Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, line := range []string{
+		`  "B" [color="blue", penwidth=2];`,
+		`  "C" [color="blue", penwidth=2];`,
+		`  "B" -- "C" ["style"="dashed", grafik_weight="3", label="3", color="blue", penwidth=2];`,
+		`  "A" -- "B" [grafik_weight="2", label="2"];`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("Expected line %s, but got %s", line, buf.String())
+		}
+	}
+}
+
+func TestEncodeQuote(t *testing.T) {
+	g := grafik.New[string]()
+	g.AddVertexByLabel(`say "hi"`)
+
+	var buf bytes.Buffer
+	if err := NewEncoder[string](&buf).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !strings.Contains(buf.String(), `"say \"hi\""`) {
+		t.Errorf("Expected escaped label, but got %s", buf.String())
+	}
+}
+
+func TestEncodeQuoteBackslash(t *testing.T) {
+	g := grafik.New[string]()
+	g.AddVertexByLabel(`C:\dir\`, options.WithVertexAttr("note", `ends with \"`))
+
+	var buf bytes.Buffer
+	if err := NewEncoder[string](&buf).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, expected := range []string{`"C:\\dir\\"`, `"ends with \\\""`} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("Expected escaped %s, but got %s", expected, buf.String())
+		}
+	}
+
+	parsed, err := Parse(&buf)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	v := parsed.GetVertexByLabel(`C:\dir\`)
+	if v == nil {
+		t.Fatalf("Expected vertex with backslashes, but got nothing")
+	}
+
+	if note, _ := v.Attr("note"); note != `ends with \"` {
+		t.Errorf("Expected note %s, but got %v", `ends with \"`, note)
+	}
+}
+
+func TestRoundTripQuotedLabel(t *testing.T) {
+	label := "first line\nC:\\new\\"
+
+	g := grafik.New[string]()
+	g.AddVertexByLabel(label, options.WithVertexAttr("label", label))
+
+	var buf bytes.Buffer
+	if err := NewEncoder[string](&buf).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	parsed, err := Parse(&buf)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	v := parsed.GetVertexByLabel(label)
+	if v == nil {
+		t.Fatalf("Expected vertex %q, but got %v", label, labelsOf(parsed.GetAllVertices()))
+	}
+
+	if attr, _ := v.Attr("label"); attr != label {
+		t.Errorf("Expected label %q, but got %q", label, attr)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("shape", "box"))
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C")
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3), options.WithEdgeAttr("style", "dashed")); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vA, vC); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		var buf bytes.Buffer
+		if err := NewEncoder[string](&buf).Encode(g); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		parsed, err := Parse(&buf)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if g.IsDirected() != parsed.IsDirected() {
+			t.Errorf("Expected directed %t, but got %t", g.IsDirected(), parsed.IsDirected())
+		}
+
+		for _, v := range g.GetAllVertices() {
+			other := parsed.GetVertexByLabel(v.Label())
+			if other == nil {
+				t.Fatalf("Expected vertex %s, but got nothing", v.Label())
+			}
+
+			if v.Weight() != other.Weight() || len(v.Attrs()) != len(other.Attrs()) {
+				t.Errorf("Expected vertex %s (%f, %v), but got (%f, %v)", v.Label(), v.Weight(), v.Attrs(), other.Weight(), other.Attrs())
+			}
+
+			for _, neighbor := range v.Neighbors() {
+				edge := g.GetEdge(v, neighbor)
+				otherEdge := parsed.GetEdge(other, parsed.GetVertexByLabel(neighbor.Label()))
+				if otherEdge == nil {
+					t.Fatalf("Expected edge %s -> %s, but got nothing", v.Label(), neighbor.Label())
+				}
+
+				if edge.Weight() != otherEdge.Weight() || len(edge.Attrs()) != len(otherEdge.Attrs()) {
+					t.Errorf("Expected edge %s -> %s (%f, %v), but got (%f, %v)", v.Label(), neighbor.Label(), edge.Weight(), edge.Attrs(), otherEdge.Weight(), otherEdge.Attrs())
+				}
+			}
+		}
+	}
+}
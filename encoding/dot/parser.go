@@ -0,0 +1,816 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+var (
+	ErrSyntax      = errors.New("dot: syntax error")
+	ErrUnsupported = errors.New("dot: unsupported feature")
+)
+
+// tokenKind represents the kind of a lexical token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenID
+	tokenPunct
+	tokenEdgeOp
+)
+
+// token represents a lexical token of the DOT language. Quoted strings,
+// numerals and identifiers are all tokenID, as the language doesn't
+// distinguish them.
+type token struct {
+	kind   tokenKind
+	value  string
+	quoted bool
+	line   int
+}
+
+// lexer splits a DOT input into tokens.
+type lexer struct {
+	r         *bufio.Reader
+	line      int
+	lineStart bool
+}
+
+// parser parses the tokens of a DOT input.
+type parser struct {
+	lex      *lexer
+	tok      token
+	directed bool
+
+	nodeDefaults map[string]string
+	edgeDefaults map[string]string
+
+	nodeOrder []string
+	nodeAttrs map[string]map[string]string
+	nodeLines map[string]int
+
+	edgeOrder []edgeKey
+	edgeAttrs map[edgeKey]map[string]string
+	edgeLines map[edgeKey]int
+}
+
+// edgeKey represents the vertices of a parsed edge.
+type edgeKey struct {
+	from string
+	to   string
+}
+
+// Parse parses a graph in the DOT language and returns it as a new graph
+// labeled by the node ids.
+//
+// Only the common subset of the language is supported: "strict", "graph"
+// and "digraph", node, edge and attribute statements, edge chains such as
+// "a -> b -> c", and comments. Subgraphs, ports and HTML strings are
+// reported with ErrUnsupported. Graph attributes are ignored.
+//
+// The "grafik_weight" attributes become the vertex and edge weights. An
+// edge without a "grafik_weight" attribute takes its weight from a numeric
+// "label". The Graphviz "weight" attribute is a layout hint, and it is
+// stored as a string attribute.
+// All other attributes are stored as string attributes. Repeated node and
+// edge statements merge their attributes.
+func Parse(r io.Reader) (grafik.Grafik[string], error) {
+	p := &parser{
+		lex:          &lexer{r: bufio.NewReader(r), line: 1, lineStart: true},
+		nodeDefaults: make(map[string]string),
+		edgeDefaults: make(map[string]string),
+		nodeAttrs:    make(map[string]map[string]string),
+		nodeLines:    make(map[string]int),
+		edgeAttrs:    make(map[edgeKey]map[string]string),
+		edgeLines:    make(map[edgeKey]int),
+	}
+
+	if err := p.parseGraph(); err != nil {
+		return nil, err
+	}
+
+	return p.build()
+}
+
+// build creates the graph from the parsed nodes and edges.
+func (p *parser) build() (grafik.Grafik[string], error) {
+	var graphOpts []options.GrafikOptionFunc
+	if p.directed {
+		graphOpts = append(graphOpts, options.WithDirected())
+	}
+
+	g := grafik.New[string](graphOpts...)
+
+	for _, id := range p.nodeOrder {
+		var opts []options.VertexOptionFunc
+		for name, value := range p.nodeAttrs[id] {
+			if name != weightAttr {
+				opts = append(opts, options.WithVertexAttr(name, value))
+				continue
+			}
+
+			weight, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: invalid weight %q of node %q", ErrSyntax, p.nodeLines[id], value, id)
+			}
+
+			opts = append(opts, options.WithVertexWeight(weight))
+		}
+
+		g.AddVertexByLabel(id, opts...)
+	}
+
+	for _, key := range p.edgeOrder {
+		attrs := p.edgeAttrs[key]
+
+		weight, hasWeight := 0.0, false
+		if value, ok := attrs[weightAttr]; ok {
+			w, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: invalid weight %q of edge %q-%q", ErrSyntax, p.edgeLines[key], value, key.from, key.to)
+			}
+
+			weight, hasWeight = w, true
+		}
+
+		opts := make([]options.EdgeOptionFunc, 0, len(attrs)+1)
+		for name, value := range attrs {
+			if name == weightAttr {
+				continue
+			}
+
+			// a numeric label is the weight, unless it disagrees with the weight attribute.
+			if name == labelAttr {
+				if w, err := strconv.ParseFloat(value, 64); err == nil && (!hasWeight || w == weight) {
+					weight, hasWeight = w, true
+					continue
+				}
+			}
+
+			opts = append(opts, options.WithEdgeAttr(name, value))
+		}
+
+		if hasWeight {
+			opts = append(opts, options.WithEdgeWeight(weight))
+		}
+
+		if _, err := g.AddEdge(g.GetVertexByLabel(key.from), g.GetVertexByLabel(key.to), opts...); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrSyntax, p.edgeLines[key], err)
+		}
+	}
+
+	return g, nil
+}
+
+// parseGraph parses: [strict] (graph | digraph) [ID] '{' stmt_list '}'
+func (p *parser) parseGraph() error {
+	if err := p.next(); err != nil {
+		return err
+	}
+
+	if p.isKeyword("strict") {
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case p.isKeyword("graph"):
+	case p.isKeyword("digraph"):
+		p.directed = true
+	default:
+		return p.unexpected("graph or digraph")
+	}
+
+	if err := p.next(); err != nil {
+		return err
+	}
+
+	if p.tok.kind == tokenID {
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+
+	for !p.isPunct("}") {
+		if p.tok.kind == tokenEOF {
+			return p.unexpected("}")
+		}
+
+		if err := p.parseStatement(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.next(); err != nil {
+		return err
+	}
+
+	if p.tok.kind != tokenEOF {
+		return p.unexpected("end of input")
+	}
+
+	return nil
+}
+
+// parseStatement parses a node, edge or attribute statement followed by
+// an optional ';'.
+func (p *parser) parseStatement() error {
+	var err error
+
+	switch {
+	case p.isKeyword("subgraph") || p.isPunct("{"):
+		return fmt.Errorf("%w: line %d: subgraph", ErrUnsupported, p.tok.line)
+	case p.isKeyword("graph"):
+		err = p.parseDefaults(nil)
+	case p.isKeyword("node"):
+		err = p.parseDefaults(p.nodeDefaults)
+	case p.isKeyword("edge"):
+		err = p.parseDefaults(p.edgeDefaults)
+	case p.tok.kind == tokenID:
+		err = p.parseNodeOrEdge()
+	default:
+		return p.unexpected("statement")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if p.isPunct(";") {
+		return p.next()
+	}
+
+	return nil
+}
+
+// parseDefaults parses an attribute statement: (graph | node | edge) attr_list.
+// The attributes are merged into defaults, or ignored if defaults is nil.
+func (p *parser) parseDefaults(defaults map[string]string) error {
+	if err := p.next(); err != nil {
+		return err
+	}
+
+	attrs, err := p.parseAttrList()
+	if err != nil {
+		return err
+	}
+
+	if defaults != nil {
+		for name, value := range attrs {
+			defaults[name] = value
+		}
+	}
+
+	return nil
+}
+
+// parseNodeOrEdge parses a node statement, a graph attribute assignment
+// such as "rankdir=LR", or an edge chain such as "a -> b -> c".
+func (p *parser) parseNodeOrEdge() error {
+	line := p.tok.line
+	ids := []string{p.tok.value}
+
+	if err := p.next(); err != nil {
+		return err
+	}
+
+	if p.isPunct("=") {
+		if err := p.next(); err != nil {
+			return err
+		}
+
+		if p.tok.kind != tokenID {
+			return p.unexpected("attribute value")
+		}
+
+		return p.next()
+	}
+
+	for {
+		if p.isPunct(":") {
+			return fmt.Errorf("%w: line %d: port", ErrUnsupported, p.tok.line)
+		}
+
+		if p.tok.kind != tokenEdgeOp {
+			break
+		}
+
+		if p.directed != (p.tok.value == "->") {
+			return fmt.Errorf("%w: line %d: edge operator %q in %s", ErrSyntax, p.tok.line, p.tok.value, p.kind())
+		}
+
+		if err := p.next(); err != nil {
+			return err
+		}
+
+		if p.isKeyword("subgraph") || p.isPunct("{") {
+			return fmt.Errorf("%w: line %d: subgraph", ErrUnsupported, p.tok.line)
+		}
+
+		if p.tok.kind != tokenID {
+			return p.unexpected("node id")
+		}
+
+		ids = append(ids, p.tok.value)
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+
+	attrs, err := p.parseAttrList()
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 1 {
+		p.addNode(ids[0], line, attrs)
+		return nil
+	}
+
+	for i := 1; i < len(ids); i++ {
+		p.addNode(ids[i-1], line, nil)
+		p.addNode(ids[i], line, nil)
+		p.addEdge(ids[i-1], ids[i], line, attrs)
+	}
+
+	return nil
+}
+
+// parseAttrList parses: ('[' [ID '=' ID [';' | ','] ...] ']')...
+// The attribute lists are optional, so an empty map is returned if there
+// are none.
+func (p *parser) parseAttrList() (map[string]string, error) {
+	attrs := make(map[string]string)
+
+	for p.isPunct("[") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		for !p.isPunct("]") {
+			if p.tok.kind != tokenID {
+				return nil, p.unexpected("attribute name")
+			}
+
+			name := p.tok.value
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+
+			if p.tok.kind != tokenID {
+				return nil, p.unexpected("attribute value")
+			}
+
+			attrs[name] = p.tok.value
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+
+			if p.isPunct(";") || p.isPunct(",") {
+				if err := p.next(); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	return attrs, nil
+}
+
+// addNode records the node with the current node defaults on its first
+// appearance, and merges the attributes into it.
+func (p *parser) addNode(id string, line int, attrs map[string]string) {
+	if _, ok := p.nodeAttrs[id]; !ok {
+		p.nodeOrder = append(p.nodeOrder, id)
+		p.nodeAttrs[id] = make(map[string]string, len(p.nodeDefaults))
+		p.nodeLines[id] = line
+
+		for name, value := range p.nodeDefaults {
+			p.nodeAttrs[id][name] = value
+		}
+	}
+
+	for name, value := range attrs {
+		p.nodeAttrs[id][name] = value
+	}
+}
+
+// addEdge records the edge with the current edge defaults on its first
+// appearance, and merges the attributes into it. In undirected graph, both
+// orders of the vertices are the same edge.
+func (p *parser) addEdge(from, to string, line int, attrs map[string]string) {
+	key := edgeKey{from: from, to: to}
+	if _, ok := p.edgeAttrs[key]; !ok && !p.directed {
+		if _, ok := p.edgeAttrs[edgeKey{from: to, to: from}]; ok {
+			key = edgeKey{from: to, to: from}
+		}
+	}
+
+	if _, ok := p.edgeAttrs[key]; !ok {
+		p.edgeOrder = append(p.edgeOrder, key)
+		p.edgeAttrs[key] = make(map[string]string, len(p.edgeDefaults)+len(attrs))
+		p.edgeLines[key] = line
+
+		for name, value := range p.edgeDefaults {
+			p.edgeAttrs[key][name] = value
+		}
+	}
+
+	for name, value := range attrs {
+		p.edgeAttrs[key][name] = value
+	}
+}
+
+// kind returns the graph keyword of the parsed graph.
+func (p *parser) kind() string {
+	if p.directed {
+		return "digraph"
+	}
+
+	return "graph"
+}
+
+// next reads the next token.
+func (p *parser) next() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+
+	p.tok = tok
+
+	return nil
+}
+
+// expect checks that the current token is the punctuation and reads the next token.
+func (p *parser) expect(punct string) error {
+	if !p.isPunct(punct) {
+		return p.unexpected(strconv.Quote(punct))
+	}
+
+	return p.next()
+}
+
+// isKeyword reports whether the current token is the unquoted keyword.
+// The keywords are case-insensitive.
+func (p *parser) isKeyword(keyword string) bool {
+	return p.tok.kind == tokenID && !p.tok.quoted && strings.EqualFold(p.tok.value, keyword)
+}
+
+// isPunct reports whether the current token is the punctuation.
+func (p *parser) isPunct(punct string) bool {
+	return p.tok.kind == tokenPunct && p.tok.value == punct
+}
+
+// unexpected returns the syntax error of an unexpected current token.
+func (p *parser) unexpected(expected string) error {
+	if p.tok.kind == tokenEOF {
+		return fmt.Errorf("%w: line %d: unexpected end of input, expected %s", ErrSyntax, p.tok.line, expected)
+	}
+
+	return fmt.Errorf("%w: line %d: unexpected %q, expected %s", ErrSyntax, p.tok.line, p.tok.value, expected)
+}
+
+// next returns the next token, skipping whitespace and comments.
+func (l *lexer) next() (token, error) {
+	if err := l.skipSpace(); err != nil {
+		return token{}, err
+	}
+
+	line := l.line
+
+	c, err := l.read()
+	if err == io.EOF {
+		return token{kind: tokenEOF, line: line}, nil
+	}
+
+	if err != nil {
+		return token{}, err
+	}
+
+	switch {
+	case c == '"':
+		value, err := l.readQuoted()
+		if err != nil {
+			return token{}, err
+		}
+
+		return token{kind: tokenID, value: value, quoted: true, line: line}, nil
+	case c == '<':
+		return token{}, fmt.Errorf("%w: line %d: HTML string", ErrUnsupported, line)
+	case c == '-':
+		peek, err := l.peek()
+		if err != nil {
+			return token{}, err
+		}
+
+		if peek == '-' || peek == '>' {
+			if _, err := l.read(); err != nil {
+				return token{}, err
+			}
+
+			return token{kind: tokenEdgeOp, value: "-" + string(peek), line: line}, nil
+		}
+
+		return l.readNumeral(c, line)
+	case c == '.' || unicode.IsDigit(c):
+		return l.readNumeral(c, line)
+	case c == '_' || unicode.IsLetter(c) || c >= 0x80:
+		return l.readID(c, line)
+	case strings.ContainsRune("{}[];,=:", c):
+		return token{kind: tokenPunct, value: string(c), line: line}, nil
+	}
+
+	return token{}, fmt.Errorf("%w: line %d: unexpected character %q", ErrSyntax, line, c)
+}
+
+// readID reads the rest of an identifier starting with c.
+func (l *lexer) readID(c rune, line int) (token, error) {
+	var b strings.Builder
+	b.WriteRune(c)
+
+	for {
+		c, err := l.peek()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return token{}, err
+		}
+
+		if c != '_' && !unicode.IsLetter(c) && !unicode.IsDigit(c) && c < 0x80 {
+			break
+		}
+
+		b.WriteRune(c)
+
+		if _, err := l.read(); err != nil {
+			return token{}, err
+		}
+	}
+
+	return token{kind: tokenID, value: b.String(), line: line}, nil
+}
+
+// readNumeral reads the rest of a numeral starting with c: [-] (.digits | digits[.digits]).
+func (l *lexer) readNumeral(c rune, line int) (token, error) {
+	var b strings.Builder
+	b.WriteRune(c)
+
+	dot := c == '.'
+	for {
+		c, err := l.peek()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return token{}, err
+		}
+
+		if c == '.' && !dot {
+			dot = true
+		} else if !unicode.IsDigit(c) {
+			break
+		}
+
+		b.WriteRune(c)
+
+		if _, err := l.read(); err != nil {
+			return token{}, err
+		}
+	}
+
+	value := b.String()
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return token{}, fmt.Errorf("%w: line %d: invalid numeral %q", ErrSyntax, line, value)
+	}
+
+	return token{kind: tokenID, value: value, line: line}, nil
+}
+
+// readQuoted reads the rest of a quoted string, including the strings
+// concatenated to it with '+'. Escaped quotes, backslashes and "\n" are
+// unescaped, as quote escapes them, and escaped line breaks are removed;
+// the other escape sequences are kept as they are.
+func (l *lexer) readQuoted() (string, error) {
+	var b strings.Builder
+
+	for {
+		c, err := l.read()
+		if err == io.EOF {
+			return "", fmt.Errorf("%w: line %d: unterminated string", ErrSyntax, l.line)
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		switch c {
+		case '"':
+			if err := l.skipSpace(); err != nil {
+				return "", err
+			}
+
+			// a '+' followed by another quoted string concatenates them.
+			if peek, err := l.peek(); err != nil || peek != '+' {
+				return b.String(), nil
+			}
+
+			if _, err := l.read(); err != nil {
+				return "", err
+			}
+
+			if err := l.skipSpace(); err != nil {
+				return "", err
+			}
+
+			if c, err := l.read(); err != nil || c != '"' {
+				return "", fmt.Errorf("%w: line %d: expected string after '+'", ErrSyntax, l.line)
+			}
+		case '\\':
+			escaped, err := l.read()
+			if err != nil {
+				return "", fmt.Errorf("%w: line %d: unterminated string", ErrSyntax, l.line)
+			}
+
+			switch escaped {
+			case '"', '\\':
+				b.WriteRune(escaped)
+			case 'n':
+				b.WriteRune('\n')
+			case '\n':
+			default:
+				b.WriteRune('\\')
+				b.WriteRune(escaped)
+			}
+		default:
+			b.WriteRune(c)
+		}
+	}
+}
+
+// skipSpace skips whitespace and comments. A '#' starts a comment only at
+// the beginning of a line, as a preprocessor output line.
+func (l *lexer) skipSpace() error {
+	for {
+		c, err := l.peek()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case unicode.IsSpace(c):
+			if _, err := l.read(); err != nil {
+				return err
+			}
+		case c == '#' && l.lineStart:
+			if err := l.skipLine(); err != nil {
+				return err
+			}
+		case c == '/':
+			next, err := l.r.Peek(2)
+			if err != nil || (next[1] != '/' && next[1] != '*') {
+				return nil
+			}
+
+			if next[1] == '/' {
+				if err := l.skipLine(); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err := l.skipBlockComment(); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// skipLine skips the rest of the line, leaving the newline.
+func (l *lexer) skipLine() error {
+	for {
+		c, err := l.peek()
+		if err == io.EOF || (err == nil && c == '\n') {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := l.read(); err != nil {
+			return err
+		}
+	}
+}
+
+// skipBlockComment skips a comment between "/*" and "*/".
+func (l *lexer) skipBlockComment() error {
+	line := l.line
+
+	// skip the "/*" that has been peeked.
+	for i := 0; i < 2; i++ {
+		if _, err := l.read(); err != nil {
+			return err
+		}
+	}
+
+	star := false
+	for {
+		c, err := l.read()
+		if err == io.EOF {
+			return fmt.Errorf("%w: line %d: unterminated comment", ErrSyntax, line)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if star && c == '/' {
+			return nil
+		}
+
+		star = c == '*'
+	}
+}
+
+// read reads the next rune, counting the lines and tracking whether only
+// whitespace has been read since the last newline.
+func (l *lexer) read() (rune, error) {
+	c, _, err := l.r.ReadRune()
+	if err != nil {
+		return c, err
+	}
+
+	switch {
+	case c == '\n':
+		l.line++
+		l.lineStart = true
+	case !unicode.IsSpace(c):
+		l.lineStart = false
+	}
+
+	return c, nil
+}
+
+// peek returns the next rune without reading it.
+func (l *lexer) peek() (rune, error) {
+	c, _, err := l.r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+
+	return c, l.r.UnreadRune()
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := `/* a hand-written fixture */
+# preprocessor line
+strict digraph "routes" {
+  rankdir=LR; // graph attribute
+  graph [splines=true]
+  node [shape=circle]
+  edge [color="gray"]
+
+  A [grafik_weight=2, label="Start"];
+  A -> B -> C [grafik_weight=1.5]
+  C -> D [label=4]
+  B -> D [label="slow"];
+  "E F"
+  A -> B [style=bold, weight=5]
+}
+`
+	g, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !g.IsDirected() {
+		t.Errorf("Expected directed graph, but got undirected")
+	}
+
+	if len(g.GetAllVertices()) != 5 {
+		t.Errorf("Expected %d vertices, but got %d", 5, len(g.GetAllVertices()))
+	}
+
+	vA := g.GetVertexByLabel("A")
+	if vA.Weight() != 2 {
+		t.Errorf("Expected weight %f, but got %f", 2.0, vA.Weight())
+	}
+
+	if label, _ := vA.Attr("label"); label != "Start" {
+		t.Errorf("Expected label %s, but got %v", "Start", label)
+	}
+
+	if shape, _ := g.GetVertexByLabel("D").Attr("shape"); shape != "circle" {
+		t.Errorf("Expected shape %s, but got %v", "circle", shape)
+	}
+
+	if g.GetVertexByLabel("E F") == nil {
+		t.Errorf("Expected vertex %s, but got nothing", "E F")
+	}
+
+	vB, vC, vD := g.GetVertexByLabel("B"), g.GetVertexByLabel("C"), g.GetVertexByLabel("D")
+
+	edge := g.GetEdge(vA, vB)
+	if edge.Weight() != 1.5 {
+		t.Errorf("Expected weight %f, but got %f", 1.5, edge.Weight())
+	}
+
+	if style, _ := edge.Attr("style"); style != "bold" {
+		t.Errorf("Expected style %s, but got %v", "bold", style)
+	}
+
+	// the Graphviz weight is a layout hint, not the edge weight.
+	if weight, _ := edge.Attr("weight"); weight != "5" {
+		t.Errorf("Expected weight attribute %s, but got %v", "5", weight)
+	}
+
+	if color, _ := edge.Attr("color"); color != "gray" {
+		t.Errorf("Expected color %s, but got %v", "gray", color)
+	}
+
+	if g.GetEdge(vB, vC).Weight() != 1.5 {
+		t.Errorf("Expected weight %f, but got %f", 1.5, g.GetEdge(vB, vC).Weight())
+	}
+
+	if g.GetEdge(vC, vD).Weight() != 4 {
+		t.Errorf("Expected weight %f, but got %f", 4.0, g.GetEdge(vC, vD).Weight())
+	}
+
+	if label, _ := g.GetEdge(vB, vD).Attr("label"); label != "slow" {
+		t.Errorf("Expected label %s, but got %v", "slow", label)
+	}
+
+	if g.ContainsEdge(vB, vA) {
+		t.Errorf("Expected no edge %s -> %s, but got one", "B", "A")
+	}
+}
+
+func TestParseUndirected(t *testing.T) {
+	g, err := Parse(strings.NewReader(`graph { a -- b; b -- a [grafik_weight=3]; b -- c }`))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if g.IsDirected() {
+		t.Errorf("Expected undirected graph, but got directed")
+	}
+
+	vA, vB := g.GetVertexByLabel("a"), g.GetVertexByLabel("b")
+	if g.GetEdge(vB, vA).Weight() != 3 {
+		t.Errorf("Expected weight %f, but got %f", 3.0, g.GetEdge(vB, vA).Weight())
+	}
+
+	if vB.OutDegree() != 2 {
+		t.Errorf("Expected out degree %d, but got %d", 2, vB.OutDegree())
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected error
+		line     string
+	}{
+		{input: `digraph { a -- b }`, expected: ErrSyntax, line: "line 1"},
+		{input: "graph {\n a -> b }", expected: ErrSyntax, line: "line 2"},
+		{input: "graph {\n\n a -- }", expected: ErrSyntax, line: "line 3"},
+		{input: `graph { a [color=] }`, expected: ErrSyntax, line: "line 1"},
+		{input: `graph { a [grafik_weight=heavy] }`, expected: ErrSyntax, line: "line 1"},
+		{input: `graph { "a }`, expected: ErrSyntax, line: "line 1"},
+		{input: `graph { a /* b }`, expected: ErrSyntax, line: "line 1"},
+		{input: `graph { a }  b`, expected: ErrSyntax, line: "line 1"},
+		{input: `tree { a }`, expected: ErrSyntax, line: "line 1"},
+		{input: `graph { subgraph s { a } }`, expected: ErrUnsupported, line: "line 1"},
+		{input: `graph { a -- { b c } }`, expected: ErrUnsupported, line: "line 1"},
+		{input: `graph { a:n -- b }`, expected: ErrUnsupported, line: "line 1"},
+		{input: `graph { a [label=<b>x</b>] }`, expected: ErrUnsupported, line: "line 1"},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(strings.NewReader(test.input))
+		if !errors.Is(err, test.expected) {
+			t.Errorf("Expected %s for %q, but got %v", test.expected, test.input, err)
+			continue
+		}
+
+		if !strings.Contains(err.Error(), test.line) {
+			t.Errorf("Expected %s in %q, but got %s", test.line, test.input, err)
+		}
+	}
+}
+
+func TestParseQuoted(t *testing.T) {
+	g, err := Parse(strings.NewReader("graph { \"say \\\"hi\\\"\" -- \"multi\" + \"part\"; -1.5 -- .5 }"))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, label := range []string{`say "hi"`, "multipart", "-1.5", ".5"} {
+		if g.GetVertexByLabel(label) == nil {
+			t.Errorf("Expected vertex %s, but got nothing", label)
+		}
+	}
+}
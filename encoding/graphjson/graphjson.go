@@ -0,0 +1,384 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package graphjson implements encoding and decoding of graphs as JSON.
+//
+// A graph is encoded as an object with the graph mode, the vertices with
+// their weights and attributes, and the edges with their weights and
+// attributes:
+//
+//	{
+//	  "directed": true,
+//	  "vertices": [{"label": "A", "weight": 1}, {"label": "B"}],
+//	  "edges": [{"source": "A", "target": "B", "weight": 2, "attrs": {"name": "A-B"}}]
+//	}
+//
+// A multigraph also has a "multigraph": true field, and each of its
+// parallel edges is an element of the edges array. A graph that rejects
+// self-loops has a "selfLoopsRejected": true field.
+//
+// The labels are encoded with encoding/json, so the label type must be
+// supported by it. The attribute values are decoded with the encoding/json
+// rules as well, which means that numbers come back as float64.
+package graphjson
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+var ErrInvalidFormat = errors.New("graphjson: invalid format")
+
+// vertexJSON represents the JSON form of a vertex.
+type vertexJSON[T comparable] struct {
+	Label  T              `json:"label"`
+	Weight float64        `json:"weight,omitempty"`
+	Attrs  map[string]any `json:"attrs,omitempty"`
+}
+
+// edgeJSON represents the JSON form of an edge.
+type edgeJSON[T comparable] struct {
+	Source T              `json:"source"`
+	Target T              `json:"target"`
+	Weight float64        `json:"weight,omitempty"`
+	Attrs  map[string]any `json:"attrs,omitempty"`
+}
+
+// Marshal returns the JSON encoding of the graph.
+func Marshal[T comparable](g grafik.Grafik[T]) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := NewEncoder[T](&buf).Encode(g); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses the JSON encoded graph and returns it as a new graph.
+func Unmarshal[T comparable](data []byte) (grafik.Grafik[T], error) {
+	return NewDecoder[T](bytes.NewReader(data)).Decode()
+}
+
+// Encoder writes graphs as JSON to an output stream. The vertices and
+// edges are written one by one, so the whole document is never kept
+// in memory.
+type Encoder[T comparable] struct {
+	w io.Writer
+}
+
+// NewEncoder returns a new encoder that writes to w.
+func NewEncoder[T comparable](w io.Writer) *Encoder[T] {
+	return &Encoder[T]{w: w}
+}
+
+// Encode writes the JSON encoding of the graph to the stream. The vertices
+// are written in the order of their labels formatted with fmt.Sprint, so the
+// output is stable. In undirected graph, each edge is written once, in the
+// direction it was added.
+func (e *Encoder[T]) Encode(g grafik.Grafik[T]) error {
+	g = grafik.Snapshot(g)
+
+	w := bufio.NewWriter(e.w)
+
+	if _, err := fmt.Fprintf(w, `{"directed":%t,`, g.IsDirected()); err != nil {
+		return err
+	}
+
+	if g.IsMultigraph() {
+		if _, err := io.WriteString(w, `"multigraph":true,`); err != nil {
+			return err
+		}
+	}
+
+	if g.IsSelfLoopsRejected() {
+		if _, err := io.WriteString(w, `"selfLoopsRejected":true,`); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, `"vertices":[`); err != nil {
+		return err
+	}
+
+	vertices := g.GetAllVertices()
+	sortVertices(vertices)
+
+	for i, v := range vertices {
+		if err := writeElement(w, i, vertexJSON[T]{Label: v.Label(), Weight: v.Weight(), Attrs: v.Attrs()}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, `],"edges":[`); err != nil {
+		return err
+	}
+
+	var i int
+	written := make(map[int]bool)
+	for _, v := range vertices {
+		// the neighbor slice belongs to the vertex, so it is sorted on a copy.
+		neighbors := slices.Clone(v.Neighbors())
+		sortVertices(neighbors)
+
+		for _, neighbor := range neighbors {
+			for _, edge := range g.GetAllEdges(v, neighbor) {
+				// skip the opposite direction of an already written undirected edge.
+				if written[edge.ID()] {
+					continue
+				}
+
+				// an undirected edge is written in the direction it was added.
+				if added := g.GetEdgeByID(edge.ID()); added != nil {
+					edge = added
+				}
+
+				if err := writeElement(w, i, edgeJSON[T]{
+					Source: edge.Source().Label(),
+					Target: edge.Destination().Label(),
+					Weight: edge.Weight(),
+					Attrs:  edge.Attrs(),
+				}); err != nil {
+					return err
+				}
+
+				i++
+				written[edge.ID()] = true
+			}
+		}
+	}
+
+	if _, err := io.WriteString(w, "]}\n"); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// sortVertices sorts the vertices by their formatted labels, so the output
+// is stable.
+func sortVertices[T comparable](vertices []*grafik.Vertex[T]) {
+	sort.Slice(vertices, func(i, j int) bool {
+		return fmt.Sprint(vertices[i].Label()) < fmt.Sprint(vertices[j].Label())
+	})
+}
+
+// writeElement writes the JSON encoding of an array element, preceded by
+// a comma if it isn't the first one.
+func writeElement(w *bufio.Writer, i int, element any) error {
+	if i > 0 {
+		if err := w.WriteByte(','); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(element)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+
+	return err
+}
+
+// Decoder reads JSON encoded graphs from an input stream. The vertices and
+// edges are added to the graph one by one, so the whole document is never
+// kept in memory.
+type Decoder[T comparable] struct {
+	dec *json.Decoder
+}
+
+// NewDecoder returns a new decoder that reads from r.
+func NewDecoder[T comparable](r io.Reader) *Decoder[T] {
+	return &Decoder[T]{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next JSON encoded graph from the stream and returns it
+// as a new graph.
+//
+// The "directed", "multigraph" and "selfLoopsRejected" fields must come
+// before the vertices and edges, and the vertices before the edges, which
+// is always the case for the documents written by Encoder.
+func (d *Decoder[T]) Decode() (grafik.Grafik[T], error) {
+	if err := d.expectDelim('{'); err != nil {
+		return nil, err
+	}
+
+	var g grafik.Grafik[T]
+	var grafikOpts []options.GrafikOptionFunc
+	var edgesDecoded bool
+
+	for d.dec.More() {
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, _ := token.(string)
+		switch key {
+		case "directed":
+			if g != nil {
+				return nil, fmt.Errorf("%w: %q must come before vertices and edges", ErrInvalidFormat, key)
+			}
+
+			var directed bool
+			if err = d.dec.Decode(&directed); err != nil {
+				return nil, err
+			}
+
+			if directed {
+				grafikOpts = append(grafikOpts, options.WithDirected())
+			}
+		case "multigraph":
+			if g != nil {
+				return nil, fmt.Errorf("%w: %q must come before vertices and edges", ErrInvalidFormat, key)
+			}
+
+			var multigraph bool
+			if err = d.dec.Decode(&multigraph); err != nil {
+				return nil, err
+			}
+
+			if multigraph {
+				grafikOpts = append(grafikOpts, options.WithMultigraph())
+			}
+		case "selfLoopsRejected":
+			if g != nil {
+				return nil, fmt.Errorf("%w: %q must come before vertices and edges", ErrInvalidFormat, key)
+			}
+
+			var rejected bool
+			if err = d.dec.Decode(&rejected); err != nil {
+				return nil, err
+			}
+
+			if rejected {
+				grafikOpts = append(grafikOpts, options.WithSelfLoopsRejected())
+			}
+		case "vertices":
+			if edgesDecoded {
+				return nil, fmt.Errorf("%w: %q must come before edges", ErrInvalidFormat, key)
+			}
+
+			if g == nil {
+				g = grafik.New[T](grafikOpts...)
+			}
+
+			err = d.decodeArray(func() error {
+				var v vertexJSON[T]
+				if err := d.dec.Decode(&v); err != nil {
+					return err
+				}
+
+				opts := []options.VertexOptionFunc{options.WithVertexWeight(v.Weight)}
+				for key, value := range v.Attrs {
+					opts = append(opts, options.WithVertexAttr(key, value))
+				}
+
+				if g.AddVertexByLabel(v.Label, opts...) == nil {
+					return fmt.Errorf("%w: duplicate vertex %v", ErrInvalidFormat, v.Label)
+				}
+
+				return nil
+			})
+		case "edges":
+			if g == nil {
+				g = grafik.New[T](grafikOpts...)
+			}
+
+			edgesDecoded = true
+
+			err = d.decodeArray(func() error {
+				var e edgeJSON[T]
+				if err := d.dec.Decode(&e); err != nil {
+					return err
+				}
+
+				opts := []options.EdgeOptionFunc{options.WithEdgeWeight(e.Weight)}
+				for key, value := range e.Attrs {
+					opts = append(opts, options.WithEdgeAttr(key, value))
+				}
+
+				if _, err := g.AddEdge(grafik.NewVertex(e.Source), grafik.NewVertex(e.Target), opts...); err != nil {
+					return fmt.Errorf("%w: edge %v -> %v: %w", ErrInvalidFormat, e.Source, e.Target, err)
+				}
+
+				return nil
+			})
+		default:
+			// skip unknown fields.
+			var raw json.RawMessage
+			err = d.dec.Decode(&raw)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := d.expectDelim('}'); err != nil {
+		return nil, err
+	}
+
+	if g == nil {
+		g = grafik.New[T](grafikOpts...)
+	}
+
+	return g, nil
+}
+
+// decodeArray reads a JSON array and calls the input function for each
+// element, while the decoder is positioned at the start of the element.
+func (d *Decoder[T]) decodeArray(decodeElement func() error) error {
+	if err := d.expectDelim('['); err != nil {
+		return err
+	}
+
+	for d.dec.More() {
+		if err := decodeElement(); err != nil {
+			return err
+		}
+	}
+
+	return d.expectDelim(']')
+}
+
+// expectDelim reads the next token and checks that it is the input delimiter.
+func (d *Decoder[T]) expectDelim(delim json.Delim) error {
+	token, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("%w: expected %s, got %v", ErrInvalidFormat, delim, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphjson
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func assertSameGrafik(t *testing.T, expected, actual grafik.Grafik[string]) {
+	t.Helper()
+
+	if expected.IsDirected() != actual.IsDirected() {
+		t.Errorf("Expected directed %t, but got %t", expected.IsDirected(), actual.IsDirected())
+	}
+
+	if len(expected.GetAllVertices()) != len(actual.GetAllVertices()) {
+		t.Errorf("Expected %d vertices, but got %d", len(expected.GetAllVertices()), len(actual.GetAllVertices()))
+	}
+
+	for _, v := range expected.GetAllVertices() {
+		other := actual.GetVertexByLabel(v.Label())
+		if other == nil {
+			t.Errorf("Expected vertex %s, but got nothing", v.Label())
+			continue
+		}
+
+		if v.Weight() != other.Weight() || !reflect.DeepEqual(v.Attrs(), other.Attrs()) {
+			t.Errorf("Expected vertex %s (%f, %v), but got (%f, %v)", v.Label(), v.Weight(), v.Attrs(), other.Weight(), other.Attrs())
+		}
+
+		if v.OutDegree() != other.OutDegree() || v.InDegree() != other.InDegree() {
+			t.Errorf("Expected vertex %s degree %d/%d, but got %d/%d", v.Label(), v.OutDegree(), v.InDegree(), other.OutDegree(), other.InDegree())
+		}
+
+		for _, neighbor := range v.Neighbors() {
+			edge := expected.GetEdge(v, neighbor)
+			otherEdge := actual.GetEdge(v, neighbor)
+			if otherEdge == nil {
+				t.Errorf("Expected edge %s -> %s, but got nothing", v.Label(), neighbor.Label())
+				continue
+			}
+
+			if edge.Weight() != otherEdge.Weight() || !reflect.DeepEqual(edge.Attrs(), otherEdge.Attrs()) {
+				t.Errorf("Expected edge %s -> %s (%f, %v), but got (%f, %v)", v.Label(), neighbor.Label(), edge.Weight(), edge.Attrs(), otherEdge.Weight(), otherEdge.Attrs())
+			}
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("name", "Bangkok"))
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C", options.WithVertexAttr("capital", true))
+		_ = g.AddVertexByLabel("D")
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2), options.WithEdgeAttr("capacity", 10.0)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vC, vA, options.WithEdgeWeight(-1)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		data, err := Marshal(g)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		decoded, err := Unmarshal[string](data)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		assertSameGrafik(t, g, decoded)
+	}
+}
+
+func TestRoundTripMultigraph(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{{options.WithMultigraph()}, {options.WithMultigraph(), options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("name", "Bangkok"))
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C", options.WithVertexAttr("capital", true))
+		_ = g.AddVertexByLabel("D")
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2), options.WithEdgeAttr("capacity", 10.0)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vC, vA, options.WithEdgeWeight(-1)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(1)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		data, err := Marshal(g)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if !bytes.Contains(data, []byte(`"multigraph":true`)) {
+			t.Errorf("Expected multigraph field, but got %s", data)
+		}
+
+		decoded, err := Unmarshal[string](data)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if !decoded.IsMultigraph() {
+			t.Errorf("Expected multigraph, but got a simple graph")
+		}
+
+		assertSameGrafik(t, g, decoded)
+
+		expected := len(g.GetAllEdges(vA, vB))
+		if edges := decoded.GetAllEdges(decoded.GetVertexByLabel("A"), decoded.GetVertexByLabel("B")); len(edges) != expected {
+			t.Errorf("Expected %d edges between A and B, but got %d", expected, len(edges))
+		}
+	}
+}
+
+func TestRoundTripSelfLoopsRejected(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{{options.WithSelfLoopsRejected()}, {options.WithSelfLoopsRejected(), options.WithMultigraph()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("name", "Bangkok"))
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C", options.WithVertexAttr("capital", true))
+		_ = g.AddVertexByLabel("D")
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2), options.WithEdgeAttr("capacity", 10.0)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vC, vA, options.WithEdgeWeight(-1)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		data, err := Marshal(g)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		decoded, err := Unmarshal[string](data)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if !decoded.IsSelfLoopsRejected() || decoded.IsMultigraph() != g.IsMultigraph() {
+			t.Errorf("Expected the graph to reject self-loops, but got %s", data)
+		}
+
+		dA := decoded.GetVertexByLabel("A")
+		if _, err = decoded.AddEdge(dA, dA); !errors.Is(err, grafik.ErrSelfLoop) {
+			t.Errorf("Expected error %s, but got %v", grafik.ErrSelfLoop, err)
+		}
+
+		assertSameGrafik(t, g, decoded)
+	}
+
+	// a graph that allows self-loops keeps allowing them.
+	decoded, err := Unmarshal[string]([]byte(`{"directed":false,"vertices":[{"label":"A"}],"edges":[{"source":"A","target":"A"}]}`))
+	if err != nil || decoded.IsSelfLoopsRejected() {
+		t.Errorf("Expected a graph that allows self-loops, but got %v", err)
+	}
+}
+
+func TestEncodeStable(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA, vB, vC := g.AddVertexByLabel("A"), g.AddVertexByLabel("B"), g.AddVertexByLabel("C")
+	if _, err := g.AddEdge(vC, vA); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vB, vA, options.WithEdgeWeight(2)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := `{"directed":false,"vertices":[{"label":"A"},{"label":"B"},{"label":"C"}],` +
+		`"edges":[{"source":"B","target":"A","weight":2},{"source":"C","target":"A"}]}` + "\n"
+
+	// the vertices are kept in a map, so a few runs would catch a random order.
+	for i := 0; i < 10; i++ {
+		data, err := Marshal(g)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if string(data) != expected {
+			t.Fatalf("Expected %s, but got %s", expected, data)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	var buf bytes.Buffer
+
+	enc := NewEncoder[string](&buf)
+	graphs := make([]grafik.Grafik[string], 0, 2)
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("name", "Bangkok"))
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C", options.WithVertexAttr("capital", true))
+		_ = g.AddVertexByLabel("D")
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2), options.WithEdgeAttr("capacity", 10.0)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vC, vA, options.WithEdgeWeight(-1)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		graphs = append(graphs, g)
+		if err := enc.Encode(g); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	dec := NewDecoder[string](&buf)
+	for _, g := range graphs {
+		decoded, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		assertSameGrafik(t, g, decoded)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	data := `{
+		"name": "unknown fields are skipped",
+		"directed": true,
+		"vertices": [{"label": 1, "weight": 2}],
+		"edges": [{"source": 1, "target": 2, "weight": 4}, {"source": 2, "target": 3}]
+	}`
+
+	g, err := Unmarshal[int]([]byte(data))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !g.IsDirected() {
+		t.Error("Expected directed graph")
+	}
+
+	if len(g.GetAllVertices()) != 3 {
+		t.Errorf("Expected %d vertices, but got %d", 3, len(g.GetAllVertices()))
+	}
+
+	if g.GetVertexByLabel(1).Weight() != 2 {
+		t.Errorf("Expected vertex weight %d, but got %f", 2, g.GetVertexByLabel(1).Weight())
+	}
+
+	if e := g.GetEdge(grafik.NewVertex(1), grafik.NewVertex(2)); e == nil || e.Weight() != 4 {
+		t.Errorf("Expected edge 1 -> 2 with weight 4, but got %+v", e)
+	}
+
+	if g.ContainsEdge(grafik.NewVertex(2), grafik.NewVertex(1)) {
+		t.Error("Expected no edge 2 -> 1")
+	}
+
+	g, err = Unmarshal[int]([]byte(`{}`))
+	if err != nil || len(g.GetAllVertices()) != 0 || g.IsDirected() {
+		t.Errorf("Expected empty undirected graph, but got %v (%v)", g, err)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []string{
+		`[]`,
+		`{"vertices": {}}`,
+		`{"vertices": [], "directed": true}`,
+		`{"edges": [{"source": "A", "target": "B"}], "vertices": [{"label": "A", "weight": 1}]}`,
+		`{"vertices": [{"label": "A"}, {"label": "A"}]}`,
+		`{"edges": [{"source": "A", "target": "B"}, {"source": "B", "target": "A"}]}`,
+	}
+
+	for _, data := range tests {
+		if _, err := Unmarshal[string]([]byte(data)); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Expected %s for %s, but got %v", ErrInvalidFormat, data, err)
+		}
+	}
+
+	if _, err := Unmarshal[string]([]byte(`{"vertices": [{"label": 1}]}`)); err == nil {
+		t.Error("Expected error, but got no error")
+	}
+
+	if _, err := NewDecoder[string](strings.NewReader(`{"vertices": [`)).Decode(); err == nil {
+		t.Error("Expected error, but got no error")
+	}
+}
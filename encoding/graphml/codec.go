@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphml
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// LabelCodec converts the vertex labels to and from the GraphML node ids.
+type LabelCodec[T comparable] interface {
+	// Encode returns the node id of the input label.
+	Encode(label T) (string, error)
+
+	// Decode returns the label of the input node id.
+	Decode(id string) (T, error)
+}
+
+// labelCodec is a LabelCodec made of a pair of functions.
+type labelCodec[T comparable] struct {
+	encode func(label T) (string, error)
+	decode func(id string) (T, error)
+}
+
+// NewLabelCodec creates a LabelCodec from a pair of functions.
+func NewLabelCodec[T comparable](encode func(label T) (string, error), decode func(id string) (T, error)) LabelCodec[T] {
+	return labelCodec[T]{encode: encode, decode: decode}
+}
+
+// Encode returns the node id of the input label.
+func (c labelCodec[T]) Encode(label T) (string, error) {
+	return c.encode(label)
+}
+
+// Decode returns the label of the input node id.
+func (c labelCodec[T]) Decode(id string) (T, error) {
+	return c.decode(id)
+}
+
+// StringCodec returns a LabelCodec that uses the string labels as node ids.
+func StringCodec() LabelCodec[string] {
+	return NewLabelCodec(
+		func(label string) (string, error) { return label, nil },
+		func(id string) (string, error) { return id, nil },
+	)
+}
+
+// IntCodec returns a LabelCodec that uses the decimal form of the int labels as node ids.
+func IntCodec() LabelCodec[int] {
+	return NewLabelCodec(
+		func(label int) (string, error) { return strconv.Itoa(label), nil },
+		func(id string) (int, error) {
+			label, err := strconv.Atoi(id)
+			if err != nil {
+				return 0, fmt.Errorf("%w: node id %q is not an int", ErrMalformed, id)
+			}
+
+			return label, nil
+		},
+	)
+}
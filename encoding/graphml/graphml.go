@@ -0,0 +1,531 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package graphml implements encoding and decoding of graphs as GraphML,
+// which is understood by tools such as Gephi and yEd.
+//
+// The vertices are written as <node> elements and the edges as <edge>
+// elements. The vertex and edge weights, and every attribute, are written
+// as <data> elements that refer to the <key> elements declared at the top
+// of the document. The weights use the "weight" attribute name, so it
+// can't be used by the attributes.
+//
+// The attribute values must be one of string, bool, int, int64, float32
+// or float64, which are written with the GraphML types string, boolean,
+// int, long, float and double.
+package graphml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+var (
+	ErrMalformed       = errors.New("graphml: malformed document")
+	ErrUnsupportedAttr = errors.New("graphml: unsupported attribute")
+)
+
+const (
+	namespace  = "http://graphml.graphdrawing.org/xmlns"
+	weightName = "weight"
+
+	forGraph = "graph"
+	forNode  = "node"
+	forEdge  = "edge"
+	forAll   = "all"
+
+	multigraphName        = "multigraph"
+	selfLoopsRejectedName = "selfLoopsRejected"
+)
+
+type graphmlXML struct {
+	XMLName xml.Name   `xml:"graphml"`
+	Xmlns   string     `xml:"xmlns,attr,omitempty"`
+	Keys    []keyXML   `xml:"key"`
+	Graphs  []graphXML `xml:"graph"`
+}
+
+type keyXML struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr"`
+	Name    string  `xml:"attr.name,attr"`
+	Type    string  `xml:"attr.type,attr"`
+	Default *string `xml:"default"`
+}
+
+type graphXML struct {
+	ID          string    `xml:"id,attr,omitempty"`
+	EdgeDefault string    `xml:"edgedefault,attr"`
+	Data        []dataXML `xml:"data"`
+	Nodes       []nodeXML `xml:"node"`
+	Edges       []edgeXML `xml:"edge"`
+}
+
+type nodeXML struct {
+	ID   string    `xml:"id,attr"`
+	Data []dataXML `xml:"data"`
+}
+
+type edgeXML struct {
+	Source   string    `xml:"source,attr"`
+	Target   string    `xml:"target,attr"`
+	Directed string    `xml:"directed,attr,omitempty"`
+	Data     []dataXML `xml:"data"`
+}
+
+type dataXML struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// typeOf returns the GraphML type of the attribute value.
+func typeOf(value any) (string, error) {
+	switch value.(type) {
+	case string:
+		return "string", nil
+	case bool:
+		return "boolean", nil
+	case int:
+		return "int", nil
+	case int64:
+		return "long", nil
+	case float32:
+		return "float", nil
+	case float64:
+		return "double", nil
+	default:
+		return "", fmt.Errorf("%w: type %T", ErrUnsupportedAttr, value)
+	}
+}
+
+// formatValue returns the GraphML form of the attribute value.
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// parseValue parses the GraphML form of the attribute value with the input type.
+func parseValue(attrType, value string) (any, error) {
+	switch attrType {
+	case "string":
+		return value, nil
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "int":
+		return strconv.Atoi(value)
+	case "long":
+		return strconv.ParseInt(value, 10, 64)
+	case "float":
+		f, err := strconv.ParseFloat(value, 32)
+		return float32(f), err
+	case "double":
+		return strconv.ParseFloat(value, 64)
+	default:
+		return nil, fmt.Errorf("%w: unknown attr.type %q", ErrMalformed, attrType)
+	}
+}
+
+// Encoder writes graphs as GraphML to an output stream.
+type Encoder[T comparable] struct {
+	w     io.Writer
+	codec LabelCodec[T]
+}
+
+// NewEncoder returns a new encoder that writes to w, and uses the codec
+// to convert the vertex labels to node ids.
+func NewEncoder[T comparable](w io.Writer, codec LabelCodec[T]) *Encoder[T] {
+	return &Encoder[T]{w: w, codec: codec}
+}
+
+// Encode writes the GraphML document of the graph to the stream. In
+// undirected graph, each edge is written once. A multigraph has a
+// "multigraph" attribute of true, and each of its parallel edges is
+// written as its own <edge> element. A graph that rejects self-loops has
+// a "selfLoopsRejected" attribute of true.
+//
+// If an attribute value has an unsupported type, or the same attribute
+// name is used with different types, returns ErrUnsupportedAttr.
+func (e *Encoder[T]) Encode(g grafik.Grafik[T]) error {
+	g = grafik.Snapshot(g)
+
+	vertices := g.GetAllVertices()
+
+	ids := make(map[T]string, len(vertices))
+	for _, v := range vertices {
+		id, err := e.codec.Encode(v.Label())
+		if err != nil {
+			return err
+		}
+
+		ids[v.Label()] = id
+	}
+
+	sort.Slice(vertices, func(i, j int) bool {
+		return ids[vertices[i].Label()] < ids[vertices[j].Label()]
+	})
+
+	nodeKeys := newKeySet(forNode)
+	edgeKeys := newKeySet(forEdge)
+
+	graph := graphXML{ID: "G", EdgeDefault: "undirected"}
+	if g.IsDirected() {
+		graph.EdgeDefault = "directed"
+	}
+
+	written := make(map[int]bool)
+	for _, v := range vertices {
+		data, err := nodeKeys.data(v.Weight(), v.Attrs())
+		if err != nil {
+			return fmt.Errorf("node %s: %w", ids[v.Label()], err)
+		}
+
+		graph.Nodes = append(graph.Nodes, nodeXML{ID: ids[v.Label()], Data: data})
+
+		for _, neighbor := range v.Neighbors() {
+			for _, edge := range g.GetAllEdges(v, neighbor) {
+				// skip the opposite direction of an already written undirected edge.
+				if edge.Source().Label() != v.Label() || written[edge.ID()] {
+					continue
+				}
+
+				data, err := edgeKeys.data(edge.Weight(), edge.Attrs())
+				if err != nil {
+					return fmt.Errorf("edge %s -> %s: %w", ids[v.Label()], ids[neighbor.Label()], err)
+				}
+
+				graph.Edges = append(graph.Edges, edgeXML{Source: ids[v.Label()], Target: ids[neighbor.Label()], Data: data})
+				written[edge.ID()] = true
+			}
+		}
+	}
+
+	keys := append(nodeKeys.keys(), edgeKeys.keys()...)
+	flags := []struct {
+		name string
+		ok   bool
+	}{
+		{multigraphName, g.IsMultigraph()},
+		{selfLoopsRejectedName, g.IsSelfLoopsRejected()},
+	}
+
+	for _, flag := range flags {
+		if !flag.ok {
+			continue
+		}
+
+		name := flag.name
+		key := keyXML{ID: forGraph + "_" + name, For: forGraph, Name: name, Type: "boolean"}
+		keys = append(keys, key)
+		graph.Data = append(graph.Data, dataXML{Key: key.ID, Value: "true"})
+	}
+
+	doc := graphmlXML{
+		Xmlns:  namespace,
+		Keys:   keys,
+		Graphs: []graphXML{graph},
+	}
+
+	if _, err := io.WriteString(e.w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(e.w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(e.w, "\n")
+
+	return err
+}
+
+// keySet collects the attribute names and types of the nodes or the edges,
+// so the <key> elements can be declared.
+type keySet struct {
+	domain string
+	types  map[string]string
+}
+
+func newKeySet(domain string) *keySet {
+	return &keySet{domain: domain, types: map[string]string{weightName: "double"}}
+}
+
+// id returns the key id of the attribute name.
+func (k *keySet) id(name string) string {
+	return k.domain + "_" + name
+}
+
+// data returns the <data> elements of the weight and the attributes, and
+// collects their types.
+func (k *keySet) data(weight float64, attrs map[string]any) ([]dataXML, error) {
+	var data []dataXML
+	if weight != 0 {
+		data = append(data, dataXML{Key: k.id(weightName), Value: formatValue(weight)})
+	}
+
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == weightName {
+			return nil, fmt.Errorf("%w: %q is reserved for the weight", ErrUnsupportedAttr, name)
+		}
+
+		attrType, err := typeOf(attrs[name])
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", name, err)
+		}
+
+		if known, ok := k.types[name]; ok && known != attrType {
+			return nil, fmt.Errorf("%w: %q is both %s and %s", ErrUnsupportedAttr, name, known, attrType)
+		}
+
+		k.types[name] = attrType
+		data = append(data, dataXML{Key: k.id(name), Value: formatValue(attrs[name])})
+	}
+
+	return data, nil
+}
+
+// keys returns the <key> elements sorted by attribute name.
+func (k *keySet) keys() []keyXML {
+	keys := make([]keyXML, 0, len(k.types))
+	for name, attrType := range k.types {
+		key := keyXML{ID: k.id(name), For: k.domain, Name: name, Type: attrType}
+		if name == weightName {
+			zero := "0"
+			key.Default = &zero
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+
+	return keys
+}
+
+// Decoder reads GraphML documents from an input stream.
+type Decoder[T comparable] struct {
+	r     io.Reader
+	codec LabelCodec[T]
+}
+
+// NewDecoder returns a new decoder that reads from r, and uses the codec
+// to convert the node ids to vertex labels.
+func NewDecoder[T comparable](r io.Reader, codec LabelCodec[T]) *Decoder[T] {
+	return &Decoder[T]{r: r, codec: codec}
+}
+
+// Decode reads the GraphML document from the stream and returns the first
+// graph in it as a new graph. The "weight" attributes of the nodes and the
+// edges are used as the vertex and edge weights. The graph is created in
+// multigraph mode if its "multigraph" attribute is true, and rejects
+// self-loops if its "selfLoopsRejected" attribute is true.
+//
+// If the document is malformed, returns an error that wraps ErrMalformed.
+func (d *Decoder[T]) Decode() (grafik.Grafik[T], error) {
+	var doc graphmlXML
+	if err := xml.NewDecoder(d.r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformed, err)
+	}
+
+	if len(doc.Graphs) == 0 {
+		return nil, fmt.Errorf("%w: no <graph> element", ErrMalformed)
+	}
+
+	keys := make(map[string]keyXML, len(doc.Keys))
+	for _, key := range doc.Keys {
+		if key.ID == "" {
+			return nil, fmt.Errorf("%w: <key> without id", ErrMalformed)
+		}
+
+		if key.Type == "" {
+			key.Type = "string"
+		}
+
+		if key.Name == "" {
+			key.Name = key.ID
+		}
+
+		keys[key.ID] = key
+	}
+
+	graph := doc.Graphs[0]
+
+	var opts []options.GrafikOptionFunc
+	switch graph.EdgeDefault {
+	case "directed":
+		opts = append(opts, options.WithDirected())
+	case "undirected":
+	default:
+		return nil, fmt.Errorf("%w: unknown edgedefault %q", ErrMalformed, graph.EdgeDefault)
+	}
+
+	_, graphAttrs, err := decodeData(keys, forGraph, graph.Data)
+	if err != nil {
+		return nil, fmt.Errorf("graph: %w", err)
+	}
+
+	if multigraph, _ := graphAttrs[multigraphName].(bool); multigraph {
+		opts = append(opts, options.WithMultigraph())
+	}
+
+	if rejected, _ := graphAttrs[selfLoopsRejectedName].(bool); rejected {
+		opts = append(opts, options.WithSelfLoopsRejected())
+	}
+
+	g := grafik.New[T](opts...)
+
+	labels := make(map[string]T, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		if node.ID == "" {
+			return nil, fmt.Errorf("%w: <node> without id", ErrMalformed)
+		}
+
+		label, err := d.codec.Decode(node.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		weight, attrs, err := decodeData(keys, forNode, node.Data)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", node.ID, err)
+		}
+
+		vertexOpts := []options.VertexOptionFunc{options.WithVertexWeight(weight)}
+		for name, value := range attrs {
+			vertexOpts = append(vertexOpts, options.WithVertexAttr(name, value))
+		}
+
+		if g.AddVertexByLabel(label, vertexOpts...) == nil {
+			return nil, fmt.Errorf("%w: duplicate node %s", ErrMalformed, node.ID)
+		}
+
+		labels[node.ID] = label
+	}
+
+	for _, edge := range graph.Edges {
+		if edge.Directed != "" && edge.Directed != strconv.FormatBool(g.IsDirected()) {
+			return nil, fmt.Errorf("%w: edge %s -> %s doesn't follow edgedefault", ErrMalformed, edge.Source, edge.Target)
+		}
+
+		source, ok := labels[edge.Source]
+		if !ok {
+			return nil, fmt.Errorf("%w: edge source %q is not a node", ErrMalformed, edge.Source)
+		}
+
+		target, ok := labels[edge.Target]
+		if !ok {
+			return nil, fmt.Errorf("%w: edge target %q is not a node", ErrMalformed, edge.Target)
+		}
+
+		weight, attrs, err := decodeData(keys, forEdge, edge.Data)
+		if err != nil {
+			return nil, fmt.Errorf("edge %s -> %s: %w", edge.Source, edge.Target, err)
+		}
+
+		edgeOpts := []options.EdgeOptionFunc{options.WithEdgeWeight(weight)}
+		for name, value := range attrs {
+			edgeOpts = append(edgeOpts, options.WithEdgeAttr(name, value))
+		}
+
+		if _, err = g.AddEdge(g.GetVertexByLabel(source), g.GetVertexByLabel(target), edgeOpts...); err != nil {
+			return nil, fmt.Errorf("%w: edge %s -> %s: %w", ErrMalformed, edge.Source, edge.Target, err)
+		}
+	}
+
+	return g, nil
+}
+
+// decodeData returns the weight and the attributes of the <data> elements,
+// including the default values of the keys that have no <data> element.
+func decodeData(keys map[string]keyXML, domain string, data []dataXML) (float64, map[string]any, error) {
+	values := make(map[string]string)
+	for _, key := range keys {
+		if key.Default != nil && (key.For == domain || key.For == forAll) {
+			values[key.ID] = *key.Default
+		}
+	}
+
+	for _, d := range data {
+		key, ok := keys[d.Key]
+		if !ok {
+			return 0, nil, fmt.Errorf("%w: unknown key %q", ErrMalformed, d.Key)
+		}
+
+		if key.For != domain && key.For != forAll {
+			return 0, nil, fmt.Errorf("%w: key %q is for %s", ErrMalformed, d.Key, key.For)
+		}
+
+		values[d.Key] = d.Value
+	}
+
+	var weight float64
+	attrs := make(map[string]any, len(values))
+	for id, value := range values {
+		key := keys[id]
+
+		parsed, err := parseValue(key.Type, value)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: key %q: %w", ErrMalformed, id, err)
+		}
+
+		if key.Name != weightName {
+			attrs[key.Name] = parsed
+			continue
+		}
+
+		switch w := parsed.(type) {
+		case float64:
+			weight = w
+		case float32:
+			weight = float64(w)
+		case int:
+			weight = float64(w)
+		case int64:
+			weight = float64(w)
+		default:
+			return 0, nil, fmt.Errorf("%w: weight must be a number, got %s", ErrMalformed, key.Type)
+		}
+	}
+
+	return weight, attrs, nil
+}
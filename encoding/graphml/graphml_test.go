@@ -0,0 +1,314 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphml
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A", options.WithVertexWeight(1.5), options.WithVertexAttr("name", "Bangkok"))
+		vB := g.AddVertexByLabel("B", options.WithVertexAttr("population", 5), options.WithVertexAttr("capital", true))
+		vC := g.AddVertexByLabel("C", options.WithVertexAttr("area", float32(2.5)))
+		_ = g.AddVertexByLabel("D", options.WithVertexAttr("id", int64(42)))
+
+		if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2), options.WithEdgeAttr("capacity", 10.5)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(3)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(vC, vA, options.WithEdgeWeight(-1), options.WithEdgeAttr("name", "C-A")); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf, StringCodec()).Encode(g); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		decoded, err := NewDecoder(&buf, StringCodec()).Decode()
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if g.IsDirected() != decoded.IsDirected() {
+			t.Errorf("Expected directed %t, but got %t", g.IsDirected(), decoded.IsDirected())
+		}
+
+		if len(decoded.GetAllVertices()) != 4 {
+			t.Errorf("Expected %d vertices, but got %d", 4, len(decoded.GetAllVertices()))
+		}
+
+		for _, v := range g.GetAllVertices() {
+			other := decoded.GetVertexByLabel(v.Label())
+			if other == nil {
+				t.Fatalf("Expected vertex %s, but got nothing", v.Label())
+			}
+
+			if v.Weight() != other.Weight() || !reflect.DeepEqual(v.Attrs(), other.Attrs()) {
+				t.Errorf("Expected vertex %s (%f, %v), but got (%f, %v)", v.Label(), v.Weight(), v.Attrs(), other.Weight(), other.Attrs())
+			}
+
+			if v.OutDegree() != other.OutDegree() || v.InDegree() != other.InDegree() {
+				t.Errorf("Expected vertex %s degree %d/%d, but got %d/%d", v.Label(), v.OutDegree(), v.InDegree(), other.OutDegree(), other.InDegree())
+			}
+
+			for _, neighbor := range v.Neighbors() {
+				edge := g.GetEdge(v, neighbor)
+				otherEdge := decoded.GetEdge(v, neighbor)
+				if otherEdge == nil {
+					t.Fatalf("Expected edge %s -> %s, but got nothing", v.Label(), neighbor.Label())
+				}
+
+				if edge.Weight() != otherEdge.Weight() || !reflect.DeepEqual(edge.Attrs(), otherEdge.Attrs()) {
+					t.Errorf("Expected edge %s -> %s (%f, %v), but got (%f, %v)", v.Label(), neighbor.Label(), edge.Weight(), edge.Attrs(), otherEdge.Weight(), otherEdge.Attrs())
+				}
+			}
+		}
+	}
+}
+
+func TestRoundTripMultigraph(t *testing.T) {
+	g := grafik.New[string](options.WithMultigraph())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(1)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf, StringCodec()).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if count := strings.Count(buf.String(), "<edge "); count != 2 {
+		t.Errorf("Expected %d edges, but got %d", 2, count)
+	}
+
+	decoded, err := NewDecoder(&buf, StringCodec()).Decode()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !decoded.IsMultigraph() {
+		t.Errorf("Expected multigraph, but got a simple graph")
+	}
+
+	if edges := decoded.GetAllEdges(decoded.GetVertexByLabel("A"), decoded.GetVertexByLabel("B")); len(edges) != 4 {
+		t.Errorf("Expected %d edges between A and B in both directions, but got %d", 4, len(edges))
+	}
+
+	if edge := decoded.GetEdge(decoded.GetVertexByLabel("A"), decoded.GetVertexByLabel("B")); edge.Weight() != 1 {
+		t.Errorf("Expected the cheapest edge of weight 1, but got %f", edge.Weight())
+	}
+}
+
+func TestRoundTripSelfLoopsRejected(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{{options.WithSelfLoopsRejected()}, {options.WithSelfLoopsRejected(), options.WithMultigraph()}} {
+		g := grafik.New[string](opts...)
+		if _, err := g.AddEdge(grafik.NewVertex("A"), grafik.NewVertex("B")); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		var buf bytes.Buffer
+		if err := NewEncoder(&buf, StringCodec()).Encode(g); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		decoded, err := NewDecoder(&buf, StringCodec()).Decode()
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if !decoded.IsSelfLoopsRejected() || decoded.IsMultigraph() != g.IsMultigraph() {
+			t.Errorf("Expected the graph to reject self-loops and multigraph %t", g.IsMultigraph())
+		}
+
+		vA := decoded.GetVertexByLabel("A")
+		if _, err = decoded.AddEdge(vA, vA); !errors.Is(err, grafik.ErrSelfLoop) {
+			t.Errorf("Expected error %s, but got %v", grafik.ErrSelfLoop, err)
+		}
+	}
+
+	g := grafik.New[string]()
+	g.AddVertexByLabel("A")
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf, StringCodec()).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if strings.Contains(buf.String(), selfLoopsRejectedName) {
+		t.Errorf("Expected no %s attribute, but got %s", selfLoopsRejectedName, buf.String())
+	}
+}
+
+func TestEncode(t *testing.T) {
+	g := grafik.New[int](options.WithDirected())
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+
+	if _, err := g.AddEdge(v1, v2, options.WithEdgeWeight(4)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf, IntCodec()).Encode(g); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, expected := range []string{
+		`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`,
+		`<key id="edge_weight" for="edge" attr.name="weight" attr.type="double">`,
+		`<graph id="G" edgedefault="directed">`,
+		`<node id="1"></node>`,
+		`<edge source="1" target="2">`,
+		`<data key="edge_weight">4</data>`,
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("Expected %s in document, but got:\n%s", expected, buf.String())
+		}
+	}
+
+	g = grafik.New[int]()
+	_ = g.AddVertexByLabel(1, options.WithVertexAttr("tags", []string{"a"}))
+	if err := NewEncoder(&buf, IntCodec()).Encode(g); !errors.Is(err, ErrUnsupportedAttr) {
+		t.Errorf("Expected %s, but got %v", ErrUnsupportedAttr, err)
+	}
+
+	g = grafik.New[int]()
+	_ = g.AddVertexByLabel(1, options.WithVertexAttr("name", "one"))
+	_ = g.AddVertexByLabel(2, options.WithVertexAttr("name", 2))
+	if err := NewEncoder(&buf, IntCodec()).Encode(g); !errors.Is(err, ErrUnsupportedAttr) {
+		t.Errorf("Expected %s, but got %v", ErrUnsupportedAttr, err)
+	}
+
+	g = grafik.New[int]()
+	_ = g.AddVertexByLabel(1, options.WithVertexAttr("weight", 1.0))
+	if err := NewEncoder(&buf, IntCodec()).Encode(g); !errors.Is(err, ErrUnsupportedAttr) {
+		t.Errorf("Expected %s, but got %v", ErrUnsupportedAttr, err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="color" attr.type="string">
+    <default>yellow</default>
+  </key>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double"/>
+  <key id="d2" for="all" attr.name="note"/>
+  <graph id="G" edgedefault="undirected">
+    <node id="n0">
+      <data key="d0">green</data>
+    </node>
+    <node id="n1"/>
+    <node id="n2">
+      <data key="d2">last</data>
+    </node>
+    <edge source="n0" target="n1">
+      <data key="d1">1.0</data>
+    </edge>
+    <edge source="n1" target="n2" directed="false"/>
+  </graph>
+</graphml>`
+
+	g, err := NewDecoder(strings.NewReader(doc), StringCodec()).Decode()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if g.IsDirected() {
+		t.Error("Expected undirected graph")
+	}
+
+	expected := map[string]map[string]any{
+		"n0": {"color": "green"},
+		"n1": {"color": "yellow"},
+		"n2": {"color": "yellow", "note": "last"},
+	}
+
+	for label, attrs := range expected {
+		v := g.GetVertexByLabel(label)
+		if v == nil {
+			t.Fatalf("Expected vertex %s, but got nothing", label)
+		}
+
+		if !reflect.DeepEqual(attrs, v.Attrs()) {
+			t.Errorf("Expected vertex %s attributes %v, but got %v", label, attrs, v.Attrs())
+		}
+	}
+
+	n0, n1 := g.GetVertexByLabel("n0"), g.GetVertexByLabel("n1")
+	if e := g.GetEdge(n1, n0); e == nil || e.Weight() != 1 {
+		t.Errorf("Expected edge n1 -> n0 with weight 1, but got %+v", e)
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	tests := []string{
+		`<graphml`,
+		`<graphml></graphml>`,
+		`<graphml><graph edgedefault="sideways"></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node/></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node id="a"/><node id="a"/></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node id="a"/><edge source="a" target="b"/></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node id="a"/><edge source="b" target="a"/></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node id="a"><data key="x">1</data></node></graph></graphml>`,
+		`<graphml><key id="x" for="edge"/><graph edgedefault="directed"><node id="a"><data key="x">1</data></node></graph></graphml>`,
+		`<graphml><key id="x" for="node" attr.type="int"/><graph edgedefault="directed"><node id="a"><data key="x">one</data></node></graph></graphml>`,
+		`<graphml><key id="x" for="node" attr.type="complex"/><graph edgedefault="directed"><node id="a"><data key="x">1</data></node></graph></graphml>`,
+		`<graphml><key id="w" for="node" attr.name="weight"/><graph edgedefault="directed"><node id="a"><data key="w">heavy</data></node></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node id="a"/><node id="b"/><edge source="a" target="b" directed="false"/></graph></graphml>`,
+		`<graphml><graph edgedefault="undirected"><node id="a"/><node id="b"/><edge source="a" target="b"/><edge source="b" target="a"/></graph></graphml>`,
+	}
+
+	for _, doc := range tests {
+		if _, err := NewDecoder(strings.NewReader(doc), StringCodec()).Decode(); !errors.Is(err, ErrMalformed) {
+			t.Errorf("Expected %s for %s, but got %v", ErrMalformed, doc, err)
+		}
+	}
+
+	doc := `<graphml><graph edgedefault="directed"><node id="a"/></graph></graphml>`
+	if _, err := NewDecoder(strings.NewReader(doc), IntCodec()).Decode(); !errors.Is(err, ErrMalformed) {
+		t.Errorf("Expected %s, but got %v", ErrMalformed, err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package flow
+
+import (
+	"math"
+
+	"github.com/fitm-elite/grafik"
+)
+
+// Dinic finds the maximum flow from the source vertex to the sink vertex
+// using Dinic's algorithm. The edge weights are the capacities. It builds
+// a level graph of the shortest distances from the source with a
+// breadth-first search, and then pushes a blocking flow through it with
+// depth-first searches, until the sink can't be reached anymore.
+//
+// The time complexity of Dinic's algorithm is O(V^2*E), and it is usually
+// faster than EdmondsKarp on large graphs.
+//
+// If any of the vertices doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If the source and the sink are the same vertex, returns ErrSourceIsSink.
+// If any edge weight is negative, returns ErrNegativeCapacity.
+func Dinic[T comparable](g grafik.Grafik[T], source, sink T) (*Flow[T], error) {
+	g = grafik.Snapshot(g)
+
+	n, err := newNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &dinic[T]{network: n, level: make([]int, len(n.arcs)), next: make([]int, len(n.arcs))}
+	for d.buildLevels() {
+		for i := range d.next {
+			d.next[i] = 0
+		}
+
+		for {
+			if pushed := d.augment(n.source, math.MaxFloat64); pushed == 0 {
+				break
+			}
+		}
+	}
+
+	return n.result(), nil
+}
+
+// dinic keeps the state of Dinic's algorithm on a residual network.
+type dinic[T comparable] struct {
+	*network[T]
+
+	level []int // the distance of each vertex from the source in the level graph, -1 if unreachable.
+	next  []int // the index of the next arc to try from each vertex, so dead ends are tried once.
+}
+
+// buildLevels computes the level graph, and returns 'true' if the sink
+// can be reached from the source.
+func (d *dinic[T]) buildLevels() bool {
+	for i := range d.level {
+		d.level[i] = -1
+	}
+
+	d.level[d.source] = 0
+
+	fifo := []int{d.source}
+	for head := 0; head < len(fifo); head++ {
+		u := fifo[head]
+		for _, a := range d.arcs[u] {
+			if a.residual > 0 && d.level[a.to] == -1 {
+				d.level[a.to] = d.level[u] + 1
+				fifo = append(fifo, a.to)
+			}
+		}
+	}
+
+	return d.level[d.sink] != -1
+}
+
+// augment pushes at most the input amount of flow from the vertex to the
+// sink along the level graph, and returns the amount pushed.
+func (d *dinic[T]) augment(u int, amount float64) float64 {
+	if u == d.sink {
+		return amount
+	}
+
+	for ; d.next[u] < len(d.arcs[u]); d.next[u]++ {
+		a := &d.arcs[u][d.next[u]]
+		if a.residual <= 0 || d.level[a.to] != d.level[u]+1 {
+			continue
+		}
+
+		if pushed := d.augment(a.to, math.Min(amount, a.residual)); pushed > 0 {
+			d.push(a, pushed)
+			return pushed
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package flow
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestDinic(t *testing.T) {
+	// the flow network from Introduction to Algorithms, whose maximum flow
+	// from s to t is 23.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range []struct {
+		from, to string
+		capacity float64
+	}{
+		{"s", "v1", 16}, {"s", "v2", 13}, {"v2", "v1", 4}, {"v1", "v3", 12}, {"v3", "v2", 9},
+		{"v2", "v4", 14}, {"v4", "v3", 7}, {"v3", "t", 20}, {"v4", "t", 4},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e.from), grafik.NewVertex(e.to), options.WithEdgeWeight(e.capacity)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	f, err := Dinic(g, "s", "t")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if f.Value() != 23 {
+		t.Errorf("Expected maximum flow 23, got %f", f.Value())
+	}
+
+	checkFlow(t, g, f, "s", "t")
+}
+
+func TestDinicOfUndirected(t *testing.T) {
+	g := grafik.New[string]()
+
+	for _, e := range []struct {
+		from, to string
+		capacity float64
+	}{{"A", "B", 3}, {"A", "C", 2}, {"C", "B", 2}, {"B", "D", 4}, {"D", "C", 1}} {
+		if _, err := g.AddEdge(grafik.NewVertex(e.from), grafik.NewVertex(e.to), options.WithEdgeWeight(e.capacity)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	f, err := Dinic(g, "A", "D")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if f.Value() != 5 {
+		t.Errorf("Expected maximum flow 5, got %f", f.Value())
+	}
+
+	// the flow C -> B goes against the order the edge was added in.
+	if f.EdgeFlow("C", "B") != 1 || f.EdgeFlow("B", "C") != 0 {
+		t.Errorf("Expected flow 1 from C to B, got %f", f.EdgeFlow("C", "B"))
+	}
+
+	checkFlow(t, g, f, "A", "D")
+}
+
+func TestDinicRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[int](opts...)
+		for i := 0; i < 30; i++ {
+			g.AddVertexByLabel(i)
+		}
+
+		for i := 0; i < 100; i++ {
+			from, to := g.GetVertexByLabel(rnd.Intn(30)), g.GetVertexByLabel(rnd.Intn(30))
+			if from != to {
+				_, _ = g.AddEdge(from, to, options.WithEdgeWeight(float64(rnd.Intn(20))))
+			}
+		}
+
+		f, err := Dinic(g, 0, 29)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		checkFlow(t, g, f, 0, 29)
+	}
+}
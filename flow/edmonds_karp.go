@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package flow
+
+import (
+	"math"
+
+	"github.com/fitm-elite/grafik"
+)
+
+// EdmondsKarp finds the maximum flow from the source vertex to the sink
+// vertex using the Edmonds-Karp algorithm. The edge weights are the
+// capacities. It repeatedly augments the flow along the shortest path with
+// residual capacity, which is found with a breadth-first search.
+//
+// The time complexity of the Edmonds-Karp algorithm is O(V*E^2).
+//
+// If any of the vertices doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If the source and the sink are the same vertex, returns ErrSourceIsSink.
+// If any edge weight is negative, returns ErrNegativeCapacity.
+func EdmondsKarp[T comparable](g grafik.Grafik[T], source, sink T) (*Flow[T], error) {
+	g = grafik.Snapshot(g)
+
+	n, err := newNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	// previous keeps the vertex and the arc index used to reach each vertex.
+	type step struct {
+		from int
+		arc  int
+	}
+
+	for {
+		previous := make([]step, len(n.arcs))
+		for i := range previous {
+			previous[i].from = -1
+		}
+
+		previous[n.source].from = n.source
+
+		fifo := []int{n.source}
+		for head := 0; head < len(fifo) && previous[n.sink].from == -1; head++ {
+			u := fifo[head]
+			for i, a := range n.arcs[u] {
+				if a.residual > 0 && previous[a.to].from == -1 {
+					previous[a.to] = step{from: u, arc: i}
+					fifo = append(fifo, a.to)
+				}
+			}
+		}
+
+		if previous[n.sink].from == -1 {
+			return n.result(), nil
+		}
+
+		// the path can carry the smallest residual capacity along it.
+		amount := math.MaxFloat64
+		for v := n.sink; v != n.source; v = previous[v].from {
+			amount = math.Min(amount, n.arcs[previous[v].from][previous[v].arc].residual)
+		}
+
+		for v := n.sink; v != n.source; v = previous[v].from {
+			n.push(&n.arcs[previous[v].from][previous[v].arc], amount)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package flow implements maximum flow algorithms, which use the edge
+// weights as capacities, and the minimum cut that they find.
+package flow
+
+import (
+	"errors"
+
+	"github.com/fitm-elite/grafik"
+)
+
+var (
+	ErrSourceIsSink     = errors.New("source and sink are the same vertex")
+	ErrNegativeCapacity = errors.New("edge capacity is negative")
+)
+
+// Flow represents a maximum flow from a source vertex to a sink vertex,
+// together with the minimum cut that separates them.
+//
+// On a concurrent graph, the cut edges belong to the grafik.Snapshot that
+// the flow was found on, so compare them with the edges of the graph by id.
+type Flow[T comparable] struct {
+	graph      grafik.Grafik[T]
+	value      float64
+	flows      map[T]map[T]float64
+	sourceSide []T
+	sinkSide   []T
+}
+
+// Value returns the value of the flow, which is the total flow leaving the
+// source vertex. It equals the capacity of the minimum cut.
+func (f *Flow[T]) Value() float64 {
+	return f.value
+}
+
+// EdgeFlow returns the flow going from the 'from' vertex to the 'to' vertex.
+// In undirected graph, the flow goes in one direction only, so the other
+// direction returns 0.
+func (f *Flow[T]) EdgeFlow(from, to T) float64 {
+	return f.flows[from][to]
+}
+
+// Flows returns the flow assignment of the edges that carry a positive
+// flow, keyed by the labels of the 'from' and the 'to' vertices.
+func (f *Flow[T]) Flows() map[T]map[T]float64 {
+	flows := make(map[T]map[T]float64, len(f.flows))
+	for from, destMap := range f.flows {
+		flows[from] = make(map[T]float64, len(destMap))
+		for to, flow := range destMap {
+			flows[from][to] = flow
+		}
+	}
+
+	return flows
+}
+
+// MinCut returns the labels of the vertices on each side of the minimum
+// s-t cut. The source side contains the vertices that can still be reached
+// from the source vertex in the residual network.
+func (f *Flow[T]) MinCut() (sourceSide, sinkSide []T) {
+	return f.sourceSide, f.sinkSide
+}
+
+// CutEdges returns the edges going from the source side to the sink side of
+// the minimum cut. The sum of their capacities is the value of the flow.
+func (f *Flow[T]) CutEdges() []*grafik.Edge[T] {
+	onSourceSide := make(map[T]bool, len(f.sourceSide))
+	for _, label := range f.sourceSide {
+		onSourceSide[label] = true
+	}
+
+	edges := make([]*grafik.Edge[T], 0)
+	for _, label := range f.sourceSide {
+		v := f.graph.GetVertexByLabel(label)
+		for _, neighbor := range v.Neighbors() {
+			if !onSourceSide[neighbor.Label()] {
+				edges = append(edges, outgoingEdges(f.graph, v, neighbor)...)
+			}
+		}
+	}
+
+	return edges
+}
+
+// outgoingEdges returns the edges going from the 'from' vertex to the 'to'
+// vertex, without the opposite direction of the undirected edges.
+func outgoingEdges[T comparable](g grafik.Grafik[T], from, to *grafik.Vertex[T]) []*grafik.Edge[T] {
+	edges := g.GetAllEdges(from, to)
+	outgoing := edges[:0:0]
+	for _, edge := range edges {
+		if edge.Source().Label() == from.Label() {
+			outgoing = append(outgoing, edge)
+		}
+	}
+
+	return outgoing
+}
+
+// arc represents an arc of the residual network. The arcs come in pairs,
+// and rev is the index of the paired arc in the adjacency of the 'to' vertex.
+type arc struct {
+	to       int
+	rev      int
+	residual float64
+	capacity float64
+}
+
+// network represents the residual network of a graph, where the vertices
+// are indexed from 0.
+type network[T comparable] struct {
+	graph  grafik.Grafik[T]
+	labels []T
+	index  map[T]int
+	arcs   [][]arc
+	source int
+	sink   int
+}
+
+// newNetwork builds the residual network of the graph. A directed edge
+// becomes an arc with its capacity paired with a reverse arc without
+// capacity. An undirected edge becomes two arcs with its capacity, paired
+// with each other.
+func newNetwork[T comparable](g grafik.Grafik[T], source, sink T) (*network[T], error) {
+	if g.GetVertexByLabel(source) == nil || g.GetVertexByLabel(sink) == nil {
+		return nil, grafik.ErrVertexDoesNotExist
+	}
+
+	if source == sink {
+		return nil, ErrSourceIsSink
+	}
+
+	vertices := g.GetAllVertices()
+	n := &network[T]{
+		graph:  g,
+		labels: make([]T, len(vertices)),
+		index:  make(map[T]int, len(vertices)),
+		arcs:   make([][]arc, len(vertices)),
+	}
+
+	for i, v := range vertices {
+		n.labels[i] = v.Label()
+		n.index[v.Label()] = i
+	}
+
+	for _, v := range vertices {
+		from := n.index[v.Label()]
+		for _, neighbor := range v.Neighbors() {
+			to := n.index[neighbor.Label()]
+
+			// a self-loop can't carry any flow from the source to the sink.
+			if to == from {
+				continue
+			}
+
+			// the parallel edges of a multigraph add up their capacities.
+			var capacity float64
+			for _, edge := range outgoingEdges(g, v, neighbor) {
+				if edge.Weight() < 0 {
+					return nil, ErrNegativeCapacity
+				}
+
+				capacity += edge.Weight()
+			}
+
+			// the edge in the opposite direction of an undirected graph is already paired.
+			if !g.IsDirected() && to < from {
+				continue
+			}
+
+			reverse := 0.0
+			if !g.IsDirected() {
+				reverse = capacity
+			}
+
+			n.arcs[from] = append(n.arcs[from], arc{to: to, rev: len(n.arcs[to]), residual: capacity, capacity: capacity})
+			n.arcs[to] = append(n.arcs[to], arc{to: from, rev: len(n.arcs[from]) - 1, residual: reverse, capacity: reverse})
+		}
+	}
+
+	n.source, n.sink = n.index[source], n.index[sink]
+
+	return n, nil
+}
+
+// push sends the amount of flow along the arc.
+func (n *network[T]) push(a *arc, amount float64) {
+	a.residual -= amount
+	n.arcs[a.to][a.rev].residual += amount
+}
+
+// result collects the flow value, the flow of each edge and the minimum cut
+// from the residual network after a maximum flow has been found.
+func (n *network[T]) result() *Flow[T] {
+	f := &Flow[T]{graph: n.graph, flows: make(map[T]map[T]float64)}
+
+	for from := range n.arcs {
+		for _, a := range n.arcs[from] {
+			// the flow on an arc is the capacity it has used up, which is
+			// never positive for a reverse arc without capacity.
+			flow := a.capacity - a.residual
+			if flow <= 0 {
+				continue
+			}
+
+			if _, ok := f.flows[n.labels[from]]; !ok {
+				f.flows[n.labels[from]] = make(map[T]float64)
+			}
+
+			f.flows[n.labels[from]][n.labels[a.to]] = flow
+			if from == n.source {
+				f.value += flow
+			}
+
+			if a.to == n.source {
+				f.value -= flow
+			}
+		}
+	}
+
+	reachable := n.reachable()
+	for i, label := range n.labels {
+		if reachable[i] {
+			f.sourceSide = append(f.sourceSide, label)
+		} else {
+			f.sinkSide = append(f.sinkSide, label)
+		}
+	}
+
+	return f
+}
+
+// reachable marks the vertices that can be reached from the source vertex
+// through arcs with residual capacity.
+func (n *network[T]) reachable() []bool {
+	reached := make([]bool, len(n.arcs))
+	reached[n.source] = true
+
+	fifo := []int{n.source}
+	for head := 0; head < len(fifo); head++ {
+		for _, a := range n.arcs[fifo[head]] {
+			if a.residual > 0 && !reached[a.to] {
+				reached[a.to] = true
+				fifo = append(fifo, a.to)
+			}
+		}
+	}
+
+	return reached
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package flow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// checkFlow reports an error if the flow breaks the capacities or the
+// conservation of flow, or if its value doesn't match the minimum cut.
+func checkFlow[T comparable](t *testing.T, g grafik.Grafik[T], f *Flow[T], source, sink T) {
+	t.Helper()
+
+	balance := make(map[T]float64)
+	for from, destMap := range f.Flows() {
+		for to, flow := range destMap {
+			edge := g.GetEdge(g.GetVertexByLabel(from), g.GetVertexByLabel(to))
+			if edge == nil || flow > edge.Weight() {
+				t.Errorf("Expected flow %v -> %v within the capacity, got %f", from, to, flow)
+			}
+
+			balance[from] -= flow
+			balance[to] += flow
+		}
+	}
+
+	for label, net := range balance {
+		if label != source && label != sink && net != 0 {
+			t.Errorf("Expected conserved flow at %v, got %f", label, net)
+		}
+	}
+
+	if balance[sink] != f.Value() {
+		t.Errorf("Expected flow into the sink to be %f, got %f", f.Value(), balance[sink])
+	}
+
+	capacity := 0.0
+	for _, edge := range f.CutEdges() {
+		capacity += edge.Weight()
+	}
+
+	if capacity != f.Value() {
+		t.Errorf("Expected minimum cut capacity to be %f, got %f", f.Value(), capacity)
+	}
+}
+
+func TestFlow(t *testing.T) {
+	// the flow network from Introduction to Algorithms, whose maximum flow
+	// from s to t is 23.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range []struct {
+		from, to string
+		capacity float64
+	}{
+		{"s", "v1", 16}, {"s", "v2", 13}, {"v2", "v1", 4}, {"v1", "v3", 12}, {"v3", "v2", 9},
+		{"v2", "v4", 14}, {"v4", "v3", 7}, {"v3", "t", 20}, {"v4", "t", 4},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e.from), grafik.NewVertex(e.to), options.WithEdgeWeight(e.capacity)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	f, err := EdmondsKarp(g, "s", "t")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	sourceSide, sinkSide := f.MinCut()
+	if len(sourceSide)+len(sinkSide) != 6 || len(sourceSide) == 0 || len(sinkSide) == 0 {
+		t.Errorf("Expected a partition of 6 vertices, got %v and %v", sourceSide, sinkSide)
+	}
+
+	if f.EdgeFlow("s", "v1")+f.EdgeFlow("s", "v2") != 23 {
+		t.Errorf("Expected flow out of s to be 23, got %f", f.EdgeFlow("s", "v1")+f.EdgeFlow("s", "v2"))
+	}
+
+	if f.EdgeFlow("v1", "s") != 0 || f.EdgeFlow("x", "y") != 0 {
+		t.Errorf("Expected no flow against the edges")
+	}
+
+	flows := f.Flows()
+	flows["s"]["v1"] = -1
+	if f.EdgeFlow("s", "v1") == -1 {
+		t.Errorf("Expected a copy of the flows")
+	}
+}
+
+func TestFlowOfMultigraph(t *testing.T) {
+	g := grafik.New[string](options.WithDirected(), options.WithMultigraph())
+
+	vS := g.AddVertexByLabel("s")
+	vA := g.AddVertexByLabel("a")
+	vT := g.AddVertexByLabel("t")
+
+	// the parallel edges add up their capacities.
+	if _, err := g.AddEdge(vS, vA, options.WithEdgeWeight(3)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vS, vA, options.WithEdgeWeight(4)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vA, vT, options.WithEdgeWeight(10)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, maxFlow := range []func(grafik.Grafik[string], string, string) (*Flow[string], error){EdmondsKarp[string], Dinic[string]} {
+		f, err := maxFlow(g, "s", "t")
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if f.Value() != 7 {
+			t.Errorf("Expected max flow 7, got %f", f.Value())
+		}
+
+		if edges := f.CutEdges(); len(edges) != 2 {
+			t.Errorf("Expected both parallel edges in the cut, got %v", edges)
+		}
+	}
+}
+
+func TestFlowSelfLoop(t *testing.T) {
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[string](opts...)
+
+		s := g.AddVertexByLabel("s")
+		v := g.AddVertexByLabel("v")
+		tv := g.AddVertexByLabel("t")
+
+		if _, err := g.AddEdge(s, v, options.WithEdgeWeight(3)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(v, v, options.WithEdgeWeight(5)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if _, err := g.AddEdge(v, tv, options.WithEdgeWeight(2)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		f, err := EdmondsKarp(g, "s", "t")
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if f.Value() != 2 || f.EdgeFlow("v", "v") != 0 {
+			t.Errorf("Expected flow 2 without flow on the self-loop, got %f and %f", f.Value(), f.EdgeFlow("v", "v"))
+		}
+
+		checkFlow(t, g, f, "s", "t")
+	}
+}
+
+func TestFlowErrors(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vS := g.AddVertexByLabel("s")
+	vT := g.AddVertexByLabel("t")
+
+	if _, err := g.AddEdge(vS, vT, options.WithEdgeWeight(1)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := EdmondsKarp(g, "s", "x"); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+		t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+	}
+
+	if _, err := Dinic(g, "s", "s"); !errors.Is(err, ErrSourceIsSink) {
+		t.Errorf("Expected %s, but got %v", ErrSourceIsSink, err)
+	}
+
+	if _, err := g.AddEdge(vT, vS, options.WithEdgeWeight(-1)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := Dinic(g, "s", "t"); !errors.Is(err, ErrNegativeCapacity) {
+		t.Errorf("Expected %s, but got %v", ErrNegativeCapacity, err)
+	}
+}
@@ -22,6 +22,7 @@ package grafik
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/fitm-elite/grafik/options"
 )
@@ -30,11 +31,19 @@ var (
 	ErrNilVertices        = errors.New("vertices are nil")
 	ErrVertexDoesNotExist = errors.New("vertex does not exist")
 	ErrEdgeAlreadyExists  = errors.New("edge already exists")
+	ErrEdgeDoesNotExist   = errors.New("edge does not exist")
+	ErrSelfLoop           = errors.New("self-loop is not allowed")
 )
 
 type grafik[T comparable] struct {
 	vertices map[T]*Vertex[T]
-	edges    map[T]map[T]*Edge[T]
+	edges    map[T]map[T][]*Edge[T] // the edges from a vertex to another, several in multigraph.
+	edgeID   int                    // the id of the last added edge.
+	edgeIDs  map[int]*Edge[T]       // the edges by id, in the direction they were added.
+
+	properties options.GrafikProperties
+
+	mu *sync.RWMutex // the lock shared with the vertices, if the graph is concurrent.
 }
 
 type VertexFunc[T comparable] interface {
@@ -63,6 +72,19 @@ type VertexFunc[T comparable] interface {
 	//
 	// If the specified vertex is nil, returns 'false'.
 	ContainsVertex(v *Vertex[T]) bool
+
+	// RemoveVertexByLabel removes the vertex with the input label from the
+	// graph, together with all edges going from or to that vertex.
+	//
+	// If vertex doesn't exist, returns ErrVertexDoesNotExist.
+	RemoveVertexByLabel(label T) error
+
+	// RemoveVertex removes the input vertex from the graph, together with
+	// all edges going from or to that vertex.
+	//
+	// If the specified vertex is nil, returns ErrNilVertices.
+	// If vertex doesn't exist, returns ErrVertexDoesNotExist.
+	RemoveVertex(v *Vertex[T]) error
 }
 
 type EdgeFunc[T comparable] interface {
@@ -75,13 +97,16 @@ type EdgeFunc[T comparable] interface {
 	//
 	// It creates the input vertices if they don't exist in the graph.
 	// If any of the specified vertices is nil, returns nil.
-	// If edge already exist, returns error.
+	// If edge already exist, returns error, unless the graph is a multigraph.
+	// If the vertices are the same and self-loops are rejected, returns ErrSelfLoop.
 	AddEdge(from, to *Vertex[T], opts ...options.EdgeOptionFunc) (*Edge[T], error)
 
 	// GetAllEdges returns a slice of all edges connecting source vertex to
 	// target vertex if such vertices exist in this graph.
 	//
-	// In directed graph, it returns a single edge.
+	// In directed graph, it returns a single edge, or all parallel edges
+	// in multigraph. In undirected graph, it returns the edges in both
+	// directions.
 	//
 	// If any of the specified vertices is nil, returns nil.
 	// If any of the vertices does not exist, returns nil.
@@ -92,7 +117,8 @@ type EdgeFunc[T comparable] interface {
 	// if such vertices and such edge exist in this graph.
 	//
 	// In undirected graph, returns only the edge from the "from" vertex to
-	// the "to" vertex.
+	// the "to" vertex. In multigraph, returns the parallel edge with the
+	// lowest weight.
 	//
 	// If any of the specified vertices is nil, returns nil.
 	// If edge does not exist, returns nil.
@@ -104,18 +130,139 @@ type EdgeFunc[T comparable] interface {
 	// If any of the specified vertices does not exist in the graph, or if is nil,
 	// returns 'false'.
 	ContainsEdge(from, to *Vertex[T]) bool
+
+	// RemoveEdge removes the edge going from the source vertex to the target
+	// vertex, in directed graph.
+	//
+	// In undirected graph, it removes the edges in both directions between
+	// the specified vertices. In multigraph, it removes all parallel edges.
+	// Use RemoveEdgeByID to remove one of the parallel edges.
+	//
+	// If any of the specified vertices is nil, returns ErrNilVertices.
+	// If any of the vertices does not exist, returns ErrVertexDoesNotExist.
+	// If edge does not exist, returns ErrEdgeDoesNotExist.
+	RemoveEdge(from, to *Vertex[T]) error
+
+	// GetEdgeByID returns the edge with the input id, in the direction it
+	// was added, so the parallel edges of a multigraph can be told apart.
+	//
+	// If edge does not exist, returns nil.
+	GetEdgeByID(id int) *Edge[T]
+
+	// RemoveEdgeByID removes the edge with the input id, in both directions
+	// in undirected graph. The parallel edges between the same vertices are
+	// kept, and the vertices stay neighbors until the last of them is removed.
+	//
+	// If edge does not exist, returns ErrEdgeDoesNotExist.
+	RemoveEdgeByID(id int) error
 }
 
 type Grafik[T comparable] interface {
 	VertexFunc[T]
 	EdgeFunc[T]
+
+	// IsDirected returns 'true' if the graph was created in directed mode.
+	IsDirected() bool
+
+	// IsMultigraph returns 'true' if the graph was created in multigraph mode.
+	IsMultigraph() bool
+
+	// IsSelfLoopsRejected returns 'true' if the graph was created to reject self-loops.
+	IsSelfLoopsRejected() bool
 }
 
-func New[T comparable]() Grafik[T] {
+// New creates a new graph. The graph is undirected by default, use
+// options.WithDirected to create a directed graph, and options.WithMultigraph
+// to allow parallel edges between the same vertices.
+//
+// In multigraph, a vertex keeps each neighbor once however many edges
+// connect them, so the neighbors and the degrees count the adjacent vertices.
+//
+// An edge from a vertex to itself is a self-loop. It is added once, in both
+// directed and undirected graph, so the vertex is its own neighbor and its
+// in and out degrees grow by one. Use options.WithSelfLoopsRejected to make
+// AddEdge return ErrSelfLoop instead.
+func New[T comparable](opts ...options.GrafikOptionFunc) Grafik[T] {
+	return newGrafik[T](opts...)
+}
+
+func newGrafik[T comparable](opts ...options.GrafikOptionFunc) *grafik[T] {
+	var properties options.GrafikProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
 	return &grafik[T]{
-		vertices: make(map[T]*Vertex[T]),
-		edges:    make(map[T]map[T]*Edge[T]),
+		vertices:   make(map[T]*Vertex[T]),
+		edges:      make(map[T]map[T][]*Edge[T]),
+		edgeIDs:    make(map[int]*Edge[T]),
+		properties: properties,
+	}
+}
+
+// clone returns a copy of the graph with its own vertices and edges. The
+// copies share the properties, which can't be changed once created.
+func (g *grafik[T]) clone() *grafik[T] {
+	out := &grafik[T]{
+		vertices:   make(map[T]*Vertex[T], len(g.vertices)),
+		edges:      make(map[T]map[T][]*Edge[T], len(g.edges)),
+		edgeID:     g.edgeID,
+		edgeIDs:    make(map[int]*Edge[T], len(g.edgeIDs)),
+		properties: g.properties,
+	}
+
+	for label, v := range g.vertices {
+		out.vertices[label] = &Vertex[T]{
+			label:      label,
+			inDegree:   v.inDegree,
+			inEdges:    v.inEdges,
+			outEdges:   v.outEdges,
+			properties: v.properties,
+		}
+	}
+
+	for label, v := range g.vertices {
+		vertex := out.vertices[label]
+		for _, neighbor := range v.neighbors {
+			vertex.addNeighbor(out.vertices[neighbor.label])
+		}
+
+		for _, neighbor := range v.inNeighbors {
+			vertex.inNeighbors = append(vertex.inNeighbors, out.vertices[neighbor.label])
+		}
+	}
+
+	for from, destMap := range g.edges {
+		out.edges[from] = make(map[T][]*Edge[T], len(destMap))
+		for to, edges := range destMap {
+			cloned := make([]*Edge[T], len(edges))
+			for i, edge := range edges {
+				cloned[i] = &Edge[T]{id: edge.id, source: out.vertices[from], dest: out.vertices[to], properties: edge.properties}
+				if g.edgeIDs[edge.id] == edge {
+					out.edgeIDs[edge.id] = cloned[i]
+				}
+			}
+
+			out.edges[from][to] = cloned
+		}
 	}
+
+	return out
+}
+
+// IsDirected returns 'true' if the graph was created in directed mode.
+func (g *grafik[T]) IsDirected() bool {
+	return g.properties.IsDirected()
+}
+
+// IsMultigraph returns 'true' if the graph was created in multigraph mode.
+func (g *grafik[T]) IsMultigraph() bool {
+	return g.properties.IsMultigraph()
+}
+
+// IsSelfLoopsRejected returns 'true' if the graph was created to reject self-loops.
+func (g *grafik[T]) IsSelfLoopsRejected() bool {
+	return g.properties.IsSelfLoopsRejected()
 }
 
 //
@@ -127,6 +274,7 @@ func (g *grafik[T]) addVertex(v *Vertex[T]) *Vertex[T] {
 		return nil
 	}
 
+	v.mu = g.mu
 	g.vertices[v.label] = v
 
 	return v
@@ -192,25 +340,118 @@ func (g *grafik[T]) ContainsVertex(v *Vertex[T]) bool {
 	return g.findVertex(v.label) != nil
 }
 
+// removeVertex removes all edges going from or to the input vertex and
+// then deletes the vertex from the vertices map.
+func (g *grafik[T]) removeVertex(v *Vertex[T]) {
+	for _, neighbor := range v.neighbors {
+		g.removeEdge(v, neighbor)
+	}
+
+	// in directed graph, the incoming edges are not part of the neighbors.
+	if g.IsDirected() {
+		for _, neighbor := range v.inNeighbors {
+			g.removeEdge(neighbor, v)
+		}
+	}
+
+	delete(g.edges, v.label)
+	delete(g.vertices, v.label)
+}
+
+// RemoveVertexByLabel removes the vertex with the input label from the
+// graph, together with all edges going from or to that vertex.
+//
+// If vertex doesn't exist, returns ErrVertexDoesNotExist.
+func (g *grafik[T]) RemoveVertexByLabel(label T) error {
+	v := g.findVertex(label)
+	if v == nil {
+		return ErrVertexDoesNotExist
+	}
+
+	g.removeVertex(v)
+
+	return nil
+}
+
+// RemoveVertex removes the input vertex from the graph, together with
+// all edges going from or to that vertex.
+//
+// If the specified vertex is nil, returns ErrNilVertices.
+// If vertex doesn't exist, returns ErrVertexDoesNotExist.
+func (g *grafik[T]) RemoveVertex(v *Vertex[T]) error {
+	if v == nil {
+		return ErrNilVertices
+	}
+
+	return g.RemoveVertexByLabel(v.label)
+}
+
 //
 // Edge implementations
 //
 
-// addToEdgeMap creates a new edge struct and adds it to the edges map inside
-// the baseGraph struct. Note that it doesn't add the neighbor to the source vertex.
+// addToEdgeMap creates a new edge struct with the input id and adds it to the
+// edges map inside the baseGraph struct. Note that it doesn't add the neighbor
+// to the source vertex.
 //
 // It returns the created edge.
-func (g *grafik[T]) addToEdgeMap(from, to *Vertex[T], opts ...options.EdgeOptionFunc) *Edge[T] {
+func (g *grafik[T]) addToEdgeMap(from, to *Vertex[T], id int, opts ...options.EdgeOptionFunc) *Edge[T] {
 	edge := NewEdge(from, to, opts...)
+	edge.id = id
+
 	if _, ok := g.edges[from.label]; !ok {
-		g.edges[from.label] = map[T]*Edge[T]{to.label: edge}
+		g.edges[from.label] = map[T][]*Edge[T]{to.label: {edge}}
 	} else {
-		g.edges[from.label][to.label] = edge
+		g.edges[from.label][to.label] = append(g.edges[from.label][to.label], edge)
 	}
 
+	from.outEdges++
+	to.inEdges++
+
 	return edge
 }
 
+// removeFromEdgeMap deletes the edges going from the 'from' label to the 'to'
+// label from the edges map. Note that it doesn't remove the neighbor from the
+// source vertex.
+func (g *grafik[T]) removeFromEdgeMap(from, to T) {
+	destMap, ok := g.edges[from]
+	if !ok {
+		return
+	}
+
+	for _, edge := range destMap[to] {
+		delete(g.edgeIDs, edge.id)
+		edge.source.outEdges--
+		edge.dest.inEdges--
+	}
+
+	delete(destMap, to)
+	if len(destMap) == 0 {
+		delete(g.edges, from)
+	}
+}
+
+// removeEdge removes the edge between the input vertices from the edges map,
+// the neighbor slices and the in degree counters.
+func (g *grafik[T]) removeEdge(from, to *Vertex[T]) {
+	g.removeFromEdgeMap(from.label, to.label)
+	from.removeNeighbor(to.label)
+	to.removeInNeighbor(from.label)
+	to.inDegree--
+
+	// a self-loop is stored once, even in undirected graph.
+	if g.IsDirected() || from.label == to.label {
+		return
+	}
+
+	// remove the edge in opposite direction, if graph is undirected.
+	g.removeFromEdgeMap(to.label, from.label)
+	to.removeNeighbor(from.label)
+	from.removeInNeighbor(to.label)
+	from.inDegree--
+}
+
 // AddEdge adds and edge from the vertex with the 'from' label to
 // the vertex with the 'to' label by appending the 'to' vertex to the
 // 'neighbors' slice of the 'from' vertex, in directed graph.
@@ -220,12 +461,18 @@ func (g *grafik[T]) addToEdgeMap(from, to *Vertex[T], opts ...options.EdgeOption
 //
 // It creates the input vertices if they don't exist in the graph.
 // If any of the specified vertices is nil, returns nil.
-// If edge already exist, returns error.
+// If edge already exist, returns error, unless the graph is a multigraph.
+// In multigraph, the edge is added next to the existing ones.
+// If the vertices are the same and self-loops are rejected, returns ErrSelfLoop.
 func (g *grafik[T]) AddEdge(from, to *Vertex[T], opts ...options.EdgeOptionFunc) (*Edge[T], error) {
 	if from == nil || to == nil {
 		return nil, ErrNilVertices
 	}
 
+	if from.label == to.label && g.properties.IsSelfLoopsRejected() {
+		return nil, ErrSelfLoop
+	}
+
 	if g.findVertex(from.label) == nil {
 		g.AddVertex(from)
 	}
@@ -234,31 +481,62 @@ func (g *grafik[T]) AddEdge(from, to *Vertex[T], opts ...options.EdgeOptionFunc)
 		g.AddVertex(to)
 	}
 
-	// prevent edge-multiplicity
-	if g.ContainsEdge(from, to) {
+	// prevent edge-multiplicity, unless the graph is a multigraph.
+	parallel := g.ContainsEdge(from, to)
+	if parallel && !g.IsMultigraph() {
 		return nil, ErrEdgeAlreadyExists
 	}
 
 	from = g.vertices[from.label]
 	to = g.vertices[to.label]
 
-	from.neighbors = append(from.neighbors, to)
+	g.edgeID++
+
+	// a self-loop is stored once, even in undirected graph.
+	oneWay := g.IsDirected() || from.label == to.label
+
+	// the vertices are already neighbors through the parallel edges.
+	if parallel {
+		if !oneWay {
+			g.addToEdgeMap(to, from, g.edgeID, opts...)
+		}
+
+		return g.addEdgeByID(from, to, opts...), nil
+	}
+
+	from.addNeighbor(to)
+	to.inNeighbors = append(to.inNeighbors, from)
 	to.inDegree++
 
+	if oneWay {
+		return g.addEdgeByID(from, to, opts...), nil
+	}
+
 	// add "from" to the "to" vertex neighbor slice, if graph is undirected.
-	to.neighbors = append(to.neighbors, from)
+	to.addNeighbor(from)
+	from.inNeighbors = append(from.inNeighbors, to)
 	from.inDegree++
 
-	g.addToEdgeMap(to, from, opts...)
+	g.addToEdgeMap(to, from, g.edgeID, opts...)
 
-	return g.addToEdgeMap(from, to, opts...), nil
+	return g.addEdgeByID(from, to, opts...), nil
+}
+
+// addEdgeByID adds the edge from the 'from' vertex to the 'to' vertex to the
+// edges map with the last edge id, and keeps it as the edge of that id.
+func (g *grafik[T]) addEdgeByID(from, to *Vertex[T], opts ...options.EdgeOptionFunc) *Edge[T] {
+	edge := g.addToEdgeMap(from, to, g.edgeID, opts...)
+	g.edgeIDs[edge.id] = edge
+
+	return edge
 }
 
 // GetEdge returns an edge connecting source vertex to target vertex
 // if such vertices and such edge exist in this graph.
 //
 // In undirected graph, returns only the edge from the "from" vertex to
-// the "to" vertex.
+// the "to" vertex. In multigraph, returns the parallel edge with the
+// lowest weight.
 //
 // If any of the specified vertices is nil, returns nil.
 // If edge does not exist, returns nil.
@@ -275,17 +553,22 @@ func (g *grafik[T]) GetEdge(from, to *Vertex[T]) *Edge[T] {
 		return nil
 	}
 
-	if destMap, ok := g.edges[from.label]; ok {
-		return destMap[to.label]
+	var cheapest *Edge[T]
+	for _, edge := range g.edges[from.label][to.label] {
+		if cheapest == nil || edge.Weight() < cheapest.Weight() {
+			cheapest = edge
+		}
 	}
 
-	return nil
+	return cheapest
 }
 
 // GetAllEdges returns a slice of all edges connecting source vertex to
 // target vertex if such vertices exist in this graph.
 //
-// In directed graph, it returns a single edge.
+// In directed graph, it returns a single edge, or all parallel edges
+// in multigraph. In undirected graph, it returns the edges in both
+// directions.
 //
 // If any of the specified vertices is nil, returns nil.
 // If any of the vertices does not exist, returns nil.
@@ -303,21 +586,15 @@ func (g *grafik[T]) GetAllEdges(from, to *Vertex[T]) []*Edge[T] {
 		return nil
 	}
 
-	edges := make([]*Edge[T], 0, len(g.edges))
-
-	if destMap, ok := g.edges[from.label]; ok {
-		if edge, ok := destMap[to.label]; ok {
-			edges = append(edges, edge)
-		}
-	}
+	edges := make([]*Edge[T], 0, len(g.edges[from.label][to.label]))
+	edges = append(edges, g.edges[from.label][to.label]...)
 
-	if destMap, ok := g.edges[to.label]; ok {
-		if edge, ok := destMap[from.label]; ok {
-			edges = append(edges, edge)
-		}
+	// a self-loop is stored once, even in undirected graph.
+	if g.IsDirected() || from.label == to.label {
+		return edges
 	}
 
-	return edges
+	return append(edges, g.edges[to.label][from.label]...)
 }
 
 // ContainsEdge returns 'true' if and only if this graph contains an edge
@@ -338,11 +615,86 @@ func (g *grafik[T]) ContainsEdge(from, to *Vertex[T]) bool {
 		return false
 	}
 
-	if dest, ok := g.edges[from.label]; ok {
-		if _, ok = dest[to.label]; ok {
-			return true
-		}
+	return len(g.edges[from.label][to.label]) > 0
+}
+
+// RemoveEdge removes the edge going from the source vertex to the target
+// vertex, in directed graph.
+//
+// In undirected graph, it removes the edges in both directions between
+// the specified vertices. In multigraph, it removes all parallel edges.
+// Use RemoveEdgeByID to remove one of the parallel edges.
+//
+// If any of the specified vertices is nil, returns ErrNilVertices.
+// If any of the vertices does not exist, returns ErrVertexDoesNotExist.
+// If edge does not exist, returns ErrEdgeDoesNotExist.
+func (g *grafik[T]) RemoveEdge(from, to *Vertex[T]) error {
+	if from == nil || to == nil {
+		return ErrNilVertices
+	}
+
+	if g.findVertex(from.label) == nil || g.findVertex(to.label) == nil {
+		return ErrVertexDoesNotExist
+	}
+
+	if !g.ContainsEdge(from, to) {
+		return ErrEdgeDoesNotExist
+	}
+
+	g.removeEdge(g.vertices[from.label], g.vertices[to.label])
+
+	return nil
+}
+
+// GetEdgeByID returns the edge with the input id, in the direction it
+// was added, so the parallel edges of a multigraph can be told apart.
+//
+// If edge does not exist, returns nil.
+func (g *grafik[T]) GetEdgeByID(id int) *Edge[T] {
+	return g.edgeIDs[id]
+}
+
+// RemoveEdgeByID removes the edge with the input id, in both directions
+// in undirected graph. The parallel edges between the same vertices are
+// kept, and the vertices stay neighbors until the last of them is removed.
+//
+// If edge does not exist, returns ErrEdgeDoesNotExist.
+func (g *grafik[T]) RemoveEdgeByID(id int) error {
+	edge, ok := g.edgeIDs[id]
+	if !ok {
+		return ErrEdgeDoesNotExist
+	}
+
+	from, to := edge.source, edge.dest
+
+	// the last edge between the vertices is removed with their neighbors.
+	if len(g.edges[from.label][to.label]) == 1 {
+		g.removeEdge(from, to)
+		return nil
 	}
 
-	return false
+	g.removeFromEdgeSlice(from.label, to.label, id)
+	if !g.IsDirected() && from.label != to.label {
+		g.removeFromEdgeSlice(to.label, from.label, id)
+	}
+
+	delete(g.edgeIDs, id)
+
+	return nil
+}
+
+// removeFromEdgeSlice deletes the edge with the input id from the edges
+// going from the 'from' label to the 'to' label. The remaining edges are
+// copied to a new slice, like the neighbors of a vertex.
+func (g *grafik[T]) removeFromEdgeSlice(from, to T, id int) {
+	edges := g.edges[from][to]
+	for i, edge := range edges {
+		if edge.id == id {
+			g.edges[from][to] = append(edges[:i:i], edges[i+1:]...)
+			edge.source.outEdges--
+			edge.dest.inEdges--
+
+			return
+		}
+	}
 }
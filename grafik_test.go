@@ -21,9 +21,13 @@
 package grafik
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"slices"
 	"testing"
+
+	"github.com/fitm-elite/grafik/options"
 )
 
 const (
@@ -92,6 +96,140 @@ func TestAddEdge(t *testing.T) {
 	}
 }
 
+func TestAddEdgeOfDirected(t *testing.T) {
+	g := New[string](options.WithDirected())
+
+	if !g.IsDirected() {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	_, err := g.AddEdge(vA, vB)
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if !g.ContainsEdge(vA, vB) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if g.ContainsEdge(vB, vA) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	// the opposite direction is a different edge in directed graph.
+	_, err = g.AddEdge(vB, vA)
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	_, err = g.AddEdge(vA, vB)
+	if err == nil {
+		t.Error(testErrMsgNoError)
+	}
+}
+
+func TestDegreeOfDirected(t *testing.T) {
+	g := New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vA, vC)
+	_, _ = g.AddEdge(vB, vC)
+
+	if vA.OutDegree() != 2 || vA.InDegree() != 0 {
+		t.Errorf(testErrMsgNotEqual, "2/0", fmt.Sprintf("%d/%d", vA.OutDegree(), vA.InDegree()))
+	}
+
+	if vB.OutDegree() != 1 || vB.InDegree() != 1 {
+		t.Errorf(testErrMsgNotEqual, "1/1", fmt.Sprintf("%d/%d", vB.OutDegree(), vB.InDegree()))
+	}
+
+	if vC.OutDegree() != 0 || vC.InDegree() != 2 {
+		t.Errorf(testErrMsgNotEqual, "0/2", fmt.Sprintf("%d/%d", vC.OutDegree(), vC.InDegree()))
+	}
+
+	if vC.HasNeighbor(vA) {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestInNeighborsOfDirected(t *testing.T) {
+	g := New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vC)
+	_, _ = g.AddEdge(vB, vC)
+	_, _ = g.AddEdge(vC, vA)
+
+	inNeighbors := vC.InNeighbors()
+	if len(inNeighbors) != 2 || inNeighbors[0].Label() != "A" || inNeighbors[1].Label() != "B" {
+		t.Errorf(testErrMsgNotEqual, "[A B]", inNeighbors)
+	}
+
+	if len(vB.InNeighbors()) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(vB.InNeighbors()))
+	}
+
+	_ = g.RemoveEdge(vA, vC)
+	if len(vC.InNeighbors()) != 1 {
+		t.Errorf(testErrMsgWrongLen, 1, len(vC.InNeighbors()))
+	}
+
+	_ = g.RemoveVertex(vC)
+	if len(vA.InNeighbors()) != 0 || vB.OutDegree() != 0 {
+		t.Errorf(testErrMsgNotEqual, "0/0", fmt.Sprintf("%d/%d", len(vA.InNeighbors()), vB.OutDegree()))
+	}
+}
+
+func TestInNeighborsOfUndirected(t *testing.T) {
+	g := New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	_, _ = g.AddEdge(vA, vB)
+
+	if len(vA.InNeighbors()) != 1 || vA.InNeighbors()[0].Label() != "B" {
+		t.Errorf(testErrMsgNotEqual, "[B]", vA.InNeighbors())
+	}
+
+	_ = g.RemoveEdge(vB, vA)
+	if len(vA.InNeighbors()) != 0 || len(vB.InNeighbors()) != 0 {
+		t.Errorf(testErrMsgNotEqual, "0/0", fmt.Sprintf("%d/%d", len(vA.InNeighbors()), len(vB.InNeighbors())))
+	}
+}
+
+func TestGetEdgeOfDirected(t *testing.T) {
+	g := New[int](options.WithDirected())
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+
+	e, err := g.AddEdge(v1, v2)
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	edge := g.GetEdge(v1, v2)
+	if !reflect.DeepEqual(e, edge) {
+		t.Errorf(testErrMsgNotEqual, e, edge)
+	}
+
+	edge = g.GetEdge(v2, v1)
+	if edge != nil {
+		t.Errorf("Expected nil, but got %+v", edge)
+	}
+}
+
 func TestGetEdgeOfUndirected(t *testing.T) {
 	g := New[int]()
 
@@ -180,6 +318,316 @@ func TestGetAllEdges(t *testing.T) {
 	}
 }
 
+func TestGetAllEdgesOfDirected(t *testing.T) {
+	g := New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	_, err := g.AddEdge(vA, vB)
+	if err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	edges := g.GetAllEdges(vA, vB)
+	if len(edges) != 1 {
+		t.Errorf(testErrMsgWrongLen, 1, len(edges))
+	}
+
+	edges = g.GetAllEdges(vB, vA)
+	if len(edges) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(edges))
+	}
+}
+
+func TestMultigraph(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		opts := []options.GrafikOptionFunc{options.WithMultigraph()}
+		if directed {
+			opts = append(opts, options.WithDirected())
+		}
+
+		g := New[string](opts...)
+		if !g.IsMultigraph() {
+			t.Error(testErrMsgNotTrue)
+		}
+
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+
+		slow, err := g.AddEdge(vA, vB, options.WithEdgeWeight(5))
+		if err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		fast, err := g.AddEdge(vA, vB, options.WithEdgeWeight(2))
+		if err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(3))
+
+		if slow.ID() == fast.ID() {
+			t.Errorf("Expected parallel edges to have different ids, but both got %d", slow.ID())
+		}
+
+		// the parallel edges don't repeat the neighbor.
+		if len(vA.Neighbors()) != 1 || vA.OutDegree() != 1 || vB.InDegree() != 1 {
+			t.Errorf("Expected B once in the neighbors of A, but got %v", vA.Neighbors())
+		}
+
+		if edge := g.GetEdge(vA, vB); edge != fast {
+			t.Errorf(testErrMsgNotEqual, fast, edge)
+		}
+
+		expectedLen := 3
+		if !directed {
+			expectedLen = 6
+
+			// both directions of an undirected edge share the id.
+			if edge := g.GetEdge(vB, vA); edge.ID() != fast.ID() {
+				t.Errorf(testErrMsgNotEqual, fast.ID(), edge.ID())
+			}
+		}
+
+		if edges := g.GetAllEdges(vA, vB); len(edges) != expectedLen {
+			t.Errorf(testErrMsgWrongLen, expectedLen, len(edges))
+		}
+
+		// removing the edge removes all parallel edges.
+		if err = g.RemoveEdge(vA, vB); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if g.ContainsEdge(vA, vB) || len(g.GetAllEdges(vA, vB)) != 0 || len(vA.Neighbors()) != 0 || vB.InDegree() != 0 {
+			t.Errorf("Expected no edge between A and B, but got %v", g.GetAllEdges(vA, vB))
+		}
+	}
+
+	// a simple graph still rejects a parallel edge.
+	g := New[string]()
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	_, _ = g.AddEdge(vA, vB)
+	if _, err := g.AddEdge(vA, vB); !errors.Is(err, ErrEdgeAlreadyExists) {
+		t.Errorf(testErrMsgNotEqual, ErrEdgeAlreadyExists, err)
+	}
+
+	if g.IsMultigraph() {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestMultigraphEdgeByID(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		opts := []options.GrafikOptionFunc{options.WithMultigraph()}
+		if directed {
+			opts = append(opts, options.WithDirected())
+		}
+
+		g := New[string](opts...)
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+
+		slow, _ := g.AddEdge(vA, vB, options.WithEdgeWeight(5))
+		fast, _ := g.AddEdge(vB, vA, options.WithEdgeWeight(2))
+
+		if g.GetEdgeByID(slow.ID()) != slow || g.GetEdgeByID(fast.ID()) != fast || g.GetEdgeByID(0) != nil {
+			t.Errorf("Expected the edges by their ids, but got %v and %v", g.GetEdgeByID(slow.ID()), g.GetEdgeByID(fast.ID()))
+		}
+
+		// removing one edge keeps the parallel one, and the vertices stay neighbors.
+		if err := g.RemoveEdgeByID(fast.ID()); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if g.GetEdgeByID(fast.ID()) != nil || g.GetEdge(vA, vB) != slow || !vA.HasNeighbor(vB) {
+			t.Errorf("Expected only edge %d between A and B, but got %v", slow.ID(), g.GetAllEdges(vA, vB))
+		}
+
+		expectedLen := 1
+		if !directed {
+			expectedLen = 2
+		}
+
+		if edges := g.GetAllEdges(vA, vB); len(edges) != expectedLen {
+			t.Errorf(testErrMsgWrongLen, expectedLen, len(edges))
+		}
+
+		if directed && (vB.HasNeighbor(vA) || vA.InDegree() != 0) {
+			t.Errorf("Expected no edge from B to A, but got %v", vB.Neighbors())
+		}
+
+		if err := g.RemoveEdgeByID(fast.ID()); !errors.Is(err, ErrEdgeDoesNotExist) {
+			t.Errorf(testErrMsgNotEqual, ErrEdgeDoesNotExist, err)
+		}
+
+		// removing the last edge removes the vertices from each other's neighbors.
+		if err := g.RemoveEdgeByID(slow.ID()); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if g.ContainsEdge(vA, vB) || len(vA.Neighbors()) != 0 || vB.InDegree() != 0 || g.GetEdgeByID(slow.ID()) != nil {
+			t.Errorf("Expected no edge between A and B, but got %v", g.GetAllEdges(vA, vB))
+		}
+
+		// the ids of the edges that are removed with a vertex are gone too.
+		loop, _ := g.AddEdge(vA, vA)
+		edge, _ := g.AddEdge(vA, vB)
+		if err := g.RemoveVertex(vA); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if g.GetEdgeByID(loop.ID()) != nil || g.GetEdgeByID(edge.ID()) != nil {
+			t.Errorf("Expected no edge of A, but got %v and %v", g.GetEdgeByID(loop.ID()), g.GetEdgeByID(edge.ID()))
+		}
+	}
+}
+
+func TestMultigraphEdgeCount(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		opts := []options.GrafikOptionFunc{options.WithMultigraph()}
+		if directed {
+			opts = append(opts, options.WithDirected())
+		}
+
+		g := New[string](opts...)
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+
+		var edges []*Edge[string]
+		for i := 0; i < 3; i++ {
+			edge, err := g.AddEdge(vA, vB)
+			if err != nil {
+				t.Fatalf(testErrMsgError, err)
+			}
+
+			edges = append(edges, edge)
+		}
+
+		// the degrees count the neighbor once, and the edge counts each parallel edge.
+		if vA.OutDegree() != 1 || vA.OutEdgeCount() != 3 || vB.InDegree() != 1 || vB.InEdgeCount() != 3 {
+			t.Errorf(testErrMsgNotEqual, "1/3/1/3", fmt.Sprintf("%d/%d/%d/%d", vA.OutDegree(), vA.OutEdgeCount(), vB.InDegree(), vB.InEdgeCount()))
+		}
+
+		// an undirected edge goes in both directions.
+		expected := 3
+		if !directed {
+			expected = 6
+		}
+
+		if vA.EdgeCount() != expected || vB.EdgeCount() != expected {
+			t.Errorf(testErrMsgNotEqual, expected, fmt.Sprintf("%d and %d", vA.EdgeCount(), vB.EdgeCount()))
+		}
+
+		if err := g.RemoveEdgeByID(edges[0].ID()); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if vA.OutEdgeCount() != 2 || vB.InEdgeCount() != 2 {
+			t.Errorf(testErrMsgNotEqual, "2/2", fmt.Sprintf("%d/%d", vA.OutEdgeCount(), vB.InEdgeCount()))
+		}
+
+		if clone := g.(*grafik[string]).clone(); clone.GetVertexByLabel("A").OutEdgeCount() != 2 {
+			t.Errorf(testErrMsgNotEqual, 2, clone.GetVertexByLabel("A").OutEdgeCount())
+		}
+
+		if err := g.RemoveVertex(vB); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if vA.EdgeCount() != 0 {
+			t.Errorf(testErrMsgNotEqual, 0, vA.EdgeCount())
+		}
+	}
+}
+
+func TestSelfLoop(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		opts := []options.GrafikOptionFunc{options.WithMultigraph()}
+		if directed {
+			opts = append(opts, options.WithDirected())
+		}
+
+		g := New[string](opts...)
+
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+		_, _ = g.AddEdge(vA, vB)
+
+		loop, err := g.AddEdge(vA, vA, options.WithEdgeWeight(2))
+		if err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		expectedIn := 2
+		if directed {
+			expectedIn = 1
+		}
+
+		// the self-loop is added once, in both directed and undirected graph.
+		if !slices.Contains(vA.Neighbors(), vA) || len(vA.Neighbors()) != 2 || len(vA.InNeighbors()) != expectedIn {
+			t.Errorf("Expected A once in its own neighbors, but got %v and %v", vA.Neighbors(), vA.InNeighbors())
+		}
+
+		if vA.InDegree() != expectedIn || vA.OutDegree() != 2 {
+			t.Errorf("Expected degree %d/%d, but got %d/%d", 2, expectedIn, vA.OutDegree(), vA.InDegree())
+		}
+
+		if edges := g.GetAllEdges(vA, vA); len(edges) != 1 || edges[0] != loop {
+			t.Errorf(testErrMsgNotEqual, []*Edge[string]{loop}, edges)
+		}
+
+		// a parallel self-loop is stored once as well.
+		_, _ = g.AddEdge(vA, vA, options.WithEdgeWeight(1))
+		if edges := g.GetAllEdges(vA, vA); len(edges) != 2 || g.GetEdge(vA, vA).Weight() != 1 {
+			t.Errorf(testErrMsgWrongLen, 2, len(edges))
+		}
+
+		if err = g.RemoveEdge(vA, vA); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if g.ContainsEdge(vA, vA) || len(vA.Neighbors()) != 1 || vA.InDegree() != expectedIn-1 {
+			t.Errorf("Expected no self-loop, but got %v with in degree %d", vA.Neighbors(), vA.InDegree())
+		}
+
+		_, _ = g.AddEdge(vB, vB)
+		if err = g.RemoveVertex(vB); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if len(vA.Neighbors()) != 0 || vA.InDegree() != 0 || len(vA.InNeighbors()) != 0 {
+			t.Errorf("Expected no neighbor of A, but got %v with in degree %d", vA.Neighbors(), vA.InDegree())
+		}
+	}
+}
+
+func TestSelfLoopsRejected(t *testing.T) {
+	g := New[string](options.WithSelfLoopsRejected())
+	if !g.IsSelfLoopsRejected() || New[string]().IsSelfLoopsRejected() {
+		t.Error("Expected only the graph created with WithSelfLoopsRejected to reject self-loops")
+	}
+
+	vA := g.AddVertexByLabel("A")
+	if _, err := g.AddEdge(vA, vA); !errors.Is(err, ErrSelfLoop) {
+		t.Errorf(testErrMsgNotEqual, ErrSelfLoop, err)
+	}
+
+	// the vertex isn't created when the self-loop is rejected.
+	if _, err := g.AddEdge(NewVertex("B"), NewVertex("B")); !errors.Is(err, ErrSelfLoop) || g.GetVertexByLabel("B") != nil {
+		t.Errorf(testErrMsgNotEqual, ErrSelfLoop, err)
+	}
+
+	if len(vA.Neighbors()) != 0 || vA.Degree() != 0 {
+		t.Errorf("Expected no neighbor of A, but got %v", vA.Neighbors())
+	}
+
+	if _, err := g.AddEdge(vA, g.AddVertexByLabel("C")); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+}
+
 func TestNeighbors(t *testing.T) {
 	g := New[string]()
 
@@ -214,14 +662,181 @@ func TestNeighbors(t *testing.T) {
 		t.Errorf(testErrMsgNotEqual, 2, vA.OutDegree())
 	}
 
-	// test cloning neighbors
+	// test sharing neighbors
 	neighbors := vA.Neighbors()
 	if len(neighbors) != len(vA.neighbors) {
 		t.Errorf(testErrMsgNotEqual, len(neighbors), len(vA.neighbors))
 	}
 
-	neighbors[0].label = "D"
-	if neighbors[0].Label() == vA.neighbors[0].Label() {
-		t.Errorf(testErrMsgNotFalse)
+	if neighbors[0] != vB || neighbors[1] != vC {
+		t.Errorf(testErrMsgNotEqual, []*Vertex[string]{vB, vC}, neighbors)
+	}
+
+	// appending to the returned slice doesn't write into the neighbors.
+	_ = append(neighbors, NewVertex("D"))
+	_, _ = g.AddEdge(vA, NewVertex("E"))
+	if neighbors[0].Label() != "B" || vA.neighbors[2].Label() != "E" || len(neighbors) != 2 {
+		t.Errorf(testErrMsgNotEqual, "E", vA.neighbors[2].Label())
+	}
+
+	// removing a neighbor leaves the returned slice untouched.
+	_ = g.RemoveEdge(vA, vB)
+	if neighbors[0] != vB || vA.HasNeighbor(vB) || vA.NeighborByLabel("B") != nil {
+		t.Error(testErrMsgNotFalse)
+	}
+}
+
+func TestRemoveEdge(t *testing.T) {
+	g := New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vA, vC)
+
+	if err := g.RemoveEdge(vB, vA); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if g.ContainsEdge(vA, vB) || g.ContainsEdge(vB, vA) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if vA.HasNeighbor(vB) || vB.HasNeighbor(vA) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if vA.InDegree() != 1 || vB.InDegree() != 0 {
+		t.Errorf(testErrMsgNotEqual, "1/0", fmt.Sprintf("%d/%d", vA.InDegree(), vB.InDegree()))
+	}
+
+	if !g.ContainsEdge(vA, vC) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if err := g.RemoveEdge(vA, vB); !errors.Is(err, ErrEdgeDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrEdgeDoesNotExist, err)
+	}
+
+	if err := g.RemoveEdge(vA, NewVertex("D")); !errors.Is(err, ErrVertexDoesNotExist) {
+		t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+	}
+
+	if err := g.RemoveEdge(nil, vA); !errors.Is(err, ErrNilVertices) {
+		t.Errorf(testErrMsgNotEqual, ErrNilVertices, err)
+	}
+}
+
+func TestRemoveEdgeOfDirected(t *testing.T) {
+	g := New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vB, vA)
+
+	if err := g.RemoveEdge(vA, vB); err != nil {
+		t.Errorf(testErrMsgError, err)
+	}
+
+	if g.ContainsEdge(vA, vB) {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	if !g.ContainsEdge(vB, vA) || !vB.HasNeighbor(vA) {
+		t.Error(testErrMsgNotTrue)
+	}
+
+	if vA.OutDegree() != 0 || vB.InDegree() != 0 || vA.InDegree() != 1 {
+		t.Errorf(testErrMsgNotEqual, "0/0/1", fmt.Sprintf("%d/%d/%d", vA.OutDegree(), vB.InDegree(), vA.InDegree()))
+	}
+}
+
+func TestRemoveVertex(t *testing.T) {
+	for _, g := range []Grafik[string]{New[string](), New[string](options.WithDirected())} {
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C")
+
+		_, _ = g.AddEdge(vA, vB)
+		_, _ = g.AddEdge(vB, vC)
+		_, _ = g.AddEdge(vC, vA)
+
+		if err := g.RemoveVertex(vB); err != nil {
+			t.Errorf(testErrMsgError, err)
+		}
+
+		if g.ContainsVertex(vB) {
+			t.Error(testErrMsgNotFalse)
+		}
+
+		if len(g.GetAllVertices()) != 2 {
+			t.Errorf(testErrMsgWrongLen, 2, len(g.GetAllVertices()))
+		}
+
+		if vA.HasNeighbor(vB) || vC.HasNeighbor(vB) {
+			t.Error(testErrMsgNotFalse)
+		}
+
+		if !g.ContainsEdge(vC, vA) {
+			t.Error(testErrMsgNotTrue)
+		}
+
+		if g.IsDirected() && (vC.InDegree() != 0 || vA.OutDegree() != 0) {
+			t.Errorf(testErrMsgNotEqual, "0/0", fmt.Sprintf("%d/%d", vC.InDegree(), vA.OutDegree()))
+		}
+
+		if !g.IsDirected() && (vC.InDegree() != 1 || vA.InDegree() != 1) {
+			t.Errorf(testErrMsgNotEqual, "1/1", fmt.Sprintf("%d/%d", vC.InDegree(), vA.InDegree()))
+		}
+
+		if err := g.RemoveVertexByLabel("B"); !errors.Is(err, ErrVertexDoesNotExist) {
+			t.Errorf(testErrMsgNotEqual, ErrVertexDoesNotExist, err)
+		}
+
+		if err := g.RemoveVertex(nil); !errors.Is(err, ErrNilVertices) {
+			t.Errorf(testErrMsgNotEqual, ErrNilVertices, err)
+		}
+
+		// vertex with the same label can be added again without old edges.
+		vB = g.AddVertexByLabel("B")
+		if vB == nil || vB.Degree() != 0 {
+			t.Error(testErrMsgNotTrue)
+		}
+	}
+}
+
+func BenchmarkNeighborByLabel(b *testing.B) {
+	g := New[int]()
+
+	hub := g.AddVertexByLabel(0)
+	for i := 1; i <= 2000; i++ {
+		_, _ = g.AddEdge(hub, NewVertex(i))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = hub.NeighborByLabel(i%2000 + 1)
+	}
+}
+
+func BenchmarkNeighbors(b *testing.B) {
+	g := New[int]()
+
+	hub := g.AddVertexByLabel(0)
+	for i := 1; i <= 2000; i++ {
+		_, _ = g.AddEdge(hub, NewVertex(i))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = hub.Neighbors()
 	}
 }
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dfs implements the stack frames shared by the iterative
+// depth-first searches, so deep graphs can't overflow the call stack.
+package dfs
+
+import "github.com/fitm-elite/grafik"
+
+// Frame represents a vertex on the stack of an iterative depth-first
+// search, with the index of its next neighbor to visit.
+type Frame[T comparable] struct {
+	vertex    *grafik.Vertex[T]
+	neighbors []*grafik.Vertex[T]
+	next      int
+}
+
+// NewFrame returns the frame of the vertex, which visits its neighbors in order.
+func NewFrame[T comparable](v *grafik.Vertex[T]) *Frame[T] {
+	return &Frame[T]{vertex: v, neighbors: v.Neighbors()}
+}
+
+// Vertex returns the vertex of the frame.
+func (f *Frame[T]) Vertex() *grafik.Vertex[T] {
+	return f.vertex
+}
+
+// Next returns the next neighbor to visit, and advances the frame past it.
+//
+// It returns 'false' once every neighbor has been visited.
+func (f *Frame[T]) Next() (*grafik.Vertex[T], bool) {
+	if f.next == len(f.neighbors) {
+		return nil, false
+	}
+
+	f.next++
+
+	return f.neighbors[f.next-1], true
+}
+
+// CycleOnStack returns the labels of the vertices on the search stack from
+// the input vertex to the top of the stack, which form a cycle when the top
+// vertex has an edge to the input one.
+func CycleOnStack[T comparable](frames []*Frame[T], label T) []T {
+	i := len(frames) - 1
+	for frames[i].vertex.Label() != label {
+		i--
+	}
+
+	cycle := make([]T, 0, len(frames)-i)
+	for _, frame := range frames[i:] {
+		cycle = append(cycle, frame.vertex.Label())
+	}
+
+	return cycle
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dfs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestFrame(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB)
+	_, _ = g.AddEdge(vA, vC)
+
+	frame := NewFrame(vA)
+	if frame.Vertex() != vA {
+		t.Errorf("Expected frame of A, but got %v", frame.Vertex().Label())
+	}
+
+	labels := make([]string, 0)
+	for neighbor, ok := frame.Next(); ok; neighbor, ok = frame.Next() {
+		labels = append(labels, neighbor.Label())
+	}
+
+	if !reflect.DeepEqual(labels, []string{"B", "C"}) {
+		t.Errorf("Expected neighbors [B C], but got %v", labels)
+	}
+
+	if _, ok := frame.Next(); ok {
+		t.Errorf("Expected no more neighbors after the last one")
+	}
+}
+
+func TestCycleOnStack(t *testing.T) {
+	frames := []*Frame[string]{
+		NewFrame(grafik.NewVertex("A")),
+		NewFrame(grafik.NewVertex("B")),
+		NewFrame(grafik.NewVertex("C")),
+		NewFrame(grafik.NewVertex("D")),
+	}
+
+	if cycle := CycleOnStack(frames, "B"); !reflect.DeepEqual(cycle, []string{"B", "C", "D"}) {
+		t.Errorf("Expected cycle [B C D], but got %v", cycle)
+	}
+
+	if cycle := CycleOnStack(frames, "D"); !reflect.DeepEqual(cycle, []string{"D"}) {
+		t.Errorf("Expected cycle [D], but got %v", cycle)
+	}
+}
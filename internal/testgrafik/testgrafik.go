@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package testgrafik implements the graphs shared by the benchmarks of
+// several packages, so they measure the same graph.
+package testgrafik
+
+import (
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// NewHub returns a weighted graph of a ring of vertices, where the first
+// few vertices are hubs connected to every other vertex. The ring edges
+// weigh 1, and the hub edges weigh from 1 to 7.
+func NewHub(size, hubs int) (grafik.Grafik[int], error) {
+	g := grafik.New[int]()
+	for i := 0; i < size; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < size; i++ {
+		if err := addEdge(g, i, (i+1)%size, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	for hub := 0; hub < hubs; hub++ {
+		for i := hubs; i < size; i++ {
+			if err := addEdge(g, hub, i, float64(i%7+1)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// addEdge adds the edge between the vertices, unless the ring already
+// connects them.
+func addEdge(g grafik.Grafik[int], from, to int, weight float64) error {
+	vFrom, vTo := g.GetVertexByLabel(from), g.GetVertexByLabel(to)
+	if g.ContainsEdge(vFrom, vTo) {
+		return nil
+	}
+
+	_, err := g.AddEdge(vFrom, vTo, options.WithEdgeWeight(weight))
+
+	return err
+}
@@ -26,6 +26,8 @@ import (
 	"testing"
 
 	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/internal/testgrafik"
+	"github.com/fitm-elite/grafik/options"
 )
 
 func TestBreadFirstIterator(t *testing.T) {
@@ -131,3 +133,126 @@ func TestBreadFirstIterator(t *testing.T) {
 		t.Errorf("Expect %+v error, but got %+v", expectedErr, err)
 	}
 }
+
+func TestBreadFirstIteratorOfDirected(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	/*
+	 *  A -> B -> C
+	 *  |    |    |
+	 *  v    v    v
+	 *  D -> E -> F
+	 */
+
+	vertices := map[string]*grafik.Vertex[string]{
+		"A": g.AddVertexByLabel("A"),
+		"B": g.AddVertexByLabel("B"),
+		"C": g.AddVertexByLabel("C"),
+		"D": g.AddVertexByLabel("D"),
+		"E": g.AddVertexByLabel("E"),
+		"F": g.AddVertexByLabel("F"),
+	}
+
+	_, _ = g.AddEdge(vertices["A"], vertices["B"])
+	_, _ = g.AddEdge(vertices["A"], vertices["D"])
+	_, _ = g.AddEdge(vertices["B"], vertices["C"])
+	_, _ = g.AddEdge(vertices["B"], vertices["E"])
+	_, _ = g.AddEdge(vertices["C"], vertices["F"])
+	_, _ = g.AddEdge(vertices["D"], vertices["E"])
+	_, _ = g.AddEdge(vertices["E"], vertices["F"])
+
+	iterator, err := NewBreadthFirstIterator(g, "B")
+	if err != nil {
+		t.Errorf("Expect NewBreadthFirstIterator doesn't return error, but got %s", err)
+	}
+
+	var ordered []string
+	_ = iterator.Iterate(func(vertex *grafik.Vertex[string]) error {
+		ordered = append(ordered, vertex.Label())
+		return nil
+	})
+
+	// A and D can't be reached from B by following the edge direction.
+	expected := []string{"B", "C", "E", "F"}
+	if !reflect.DeepEqual(expected, ordered) {
+		t.Errorf("Expect same vertex order, but got different one expected: %v, actual: %v", expected, ordered)
+	}
+}
+
+func TestBreadFirstIteratorSelfLoop(t *testing.T) {
+	// a self-loop is either allowed or rejected, and is never followed.
+	for _, opts := range [][]options.GrafikOptionFunc{{options.WithDirected()}, {options.WithDirected(), options.WithSelfLoopsRejected()}} {
+		g := grafik.New[string](opts...)
+
+		vertices := map[string]*grafik.Vertex[string]{
+			"A": g.AddVertexByLabel("A"),
+			"B": g.AddVertexByLabel("B"),
+			"C": g.AddVertexByLabel("C"),
+			"D": g.AddVertexByLabel("D"),
+			"E": g.AddVertexByLabel("E"),
+			"F": g.AddVertexByLabel("F"),
+		}
+
+		_, _ = g.AddEdge(vertices["A"], vertices["A"])
+		_, _ = g.AddEdge(vertices["A"], vertices["B"])
+		_, _ = g.AddEdge(vertices["A"], vertices["D"])
+		_, _ = g.AddEdge(vertices["B"], vertices["C"])
+		_, _ = g.AddEdge(vertices["B"], vertices["E"])
+		_, _ = g.AddEdge(vertices["C"], vertices["F"])
+		_, _ = g.AddEdge(vertices["D"], vertices["E"])
+		_, _ = g.AddEdge(vertices["E"], vertices["E"])
+		_, _ = g.AddEdge(vertices["E"], vertices["F"])
+
+		iterator, err := NewBreadthFirstIterator(g, "A")
+		if err != nil {
+			t.Errorf("Expect NewBreadthFirstIterator doesn't return error, but got %s", err)
+		}
+
+		var ordered []string
+		_ = iterator.Iterate(func(vertex *grafik.Vertex[string]) error {
+			ordered = append(ordered, vertex.Label())
+			return nil
+		})
+
+		expected := []string{"A", "B", "D", "C", "E", "F"}
+		if !reflect.DeepEqual(expected, ordered) {
+			t.Errorf("Expect same vertex order, but got different one expected: %v, actual: %v", expected, ordered)
+		}
+	}
+}
+
+func TestBreadFirstIteratorAttr(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A", options.WithVertexAttr("colour", "red"))
+	vB := g.AddVertexByLabel("B", options.WithVertexAttr("colour", "blue"))
+
+	_, _ = g.AddEdge(vA, vB)
+
+	iterator, _ := NewBreadthFirstIterator(g, "A")
+	colours := make(map[string]string)
+	_ = iterator.Iterate(func(vertex *grafik.Vertex[string]) error {
+		colours[vertex.Label()], _ = grafik.VertexAttrAs[string](vertex, "colour")
+		return nil
+	})
+
+	expected := map[string]string{"A": "red", "B": "blue"}
+	if !reflect.DeepEqual(expected, colours) {
+		t.Errorf("Expect same attributes, but got different one expected: %v, actual: %v", expected, colours)
+	}
+}
+
+func BenchmarkBreadthFirstIterator(b *testing.B) {
+	g, err := testgrafik.NewHub(2000, 10)
+	if err != nil {
+		b.Fatalf("Expected no error, but got %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		iterator, _ := NewBreadthFirstIterator(g, 0)
+		_ = iterator.Iterate(func(*grafik.Vertex[int]) error { return nil })
+	}
+}
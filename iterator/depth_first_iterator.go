@@ -32,7 +32,12 @@ type depthFirstIterator[T comparable] struct {
 
 // NewDepthFirstIterator creates a new instance of depthFirstIterator
 // and returns it as the Iterator interface.
+//
+// On a concurrent graph, it iterates over a grafik.Snapshot that is taken
+// when it is created, so the vertices it returns belong to the snapshot.
 func NewDepthFirstIterator[T comparable](g grafik.Grafik[T], start T) (Iterator[T], error) {
+	g = grafik.Snapshot(g)
+
 	v := g.GetVertexByLabel(start)
 	if v == nil {
 		return nil, grafik.ErrVertexDoesNotExist
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package options
+
+// BetweennessOptionFunc represent an alias of function type that modifies the specified betweenness properties.
+type BetweennessOptionFunc func(properties *BetweennessProperties)
+
+// BetweennessProperties represents the properties of a betweenness centrality.
+type BetweennessProperties struct {
+	weighted   bool
+	normalized bool
+}
+
+// IsWeighted returns b.weighted from BetweennessProperties.
+func (b BetweennessProperties) IsWeighted() bool {
+	return b.weighted
+}
+
+// IsNormalized returns b.normalized from BetweennessProperties.
+func (b BetweennessProperties) IsNormalized() bool {
+	return b.normalized
+}
+
+// WithBetweennessWeighted sets the edge weights to be used as path lengths for the specified
+// betweenness properties in the returned BetweennessOptionFunc. Otherwise, every edge has a length of one.
+func WithBetweennessWeighted() BetweennessOptionFunc {
+	return func(properties *BetweennessProperties) {
+		properties.weighted = true
+	}
+}
+
+// WithBetweennessNormalized sets the scores to be normalized by the number of vertex pairs for
+// the specified betweenness properties in the returned BetweennessOptionFunc.
+func WithBetweennessNormalized() BetweennessOptionFunc {
+	return func(properties *BetweennessProperties) {
+		properties.normalized = true
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package options
+
+// closenessMode represents the formula that is used to calculate closeness centrality.
+type closenessMode int
+
+const (
+	closenessStandard closenessMode = iota
+	closenessWassermanFaust
+	closenessHarmonic
+)
+
+// CentralityOptionFunc represent an alias of function type that modifies the specified centrality properties.
+type CentralityOptionFunc func(properties *CentralityProperties)
+
+// CentralityProperties represents the properties of a centrality.
+type CentralityProperties struct {
+	closeness       closenessMode
+	dijkstraOptions []DijkstraOptionFunc
+	workers         int
+	progress        func(done, total int)
+}
+
+// IsWassermanFaust returns true if the Wasserman-Faust closeness is selected in CentralityProperties.
+func (c CentralityProperties) IsWassermanFaust() bool {
+	return c.closeness == closenessWassermanFaust
+}
+
+// IsHarmonic returns true if the harmonic closeness is selected in CentralityProperties.
+func (c CentralityProperties) IsHarmonic() bool {
+	return c.closeness == closenessHarmonic
+}
+
+// GetDijkstraOptions returns c.dijkstraOptions from CentralityProperties.
+func (c CentralityProperties) GetDijkstraOptions() []DijkstraOptionFunc {
+	return c.dijkstraOptions
+}
+
+// GetWorkers returns c.workers from CentralityProperties.
+func (c CentralityProperties) GetWorkers() int {
+	return c.workers
+}
+
+// GetProgress returns c.progress from CentralityProperties.
+func (c CentralityProperties) GetProgress() func(done, total int) {
+	return c.progress
+}
+
+// WithClosenessWassermanFaust sets the Wasserman-Faust closeness for the specified centrality properties
+// in the returned CentralityOptionFunc. It scales the closeness by the fraction of reachable vertices.
+func WithClosenessWassermanFaust() CentralityOptionFunc {
+	return func(properties *CentralityProperties) {
+		properties.closeness = closenessWassermanFaust
+	}
+}
+
+// WithClosenessHarmonic sets the harmonic closeness for the specified centrality properties in the
+// returned CentralityOptionFunc. It averages the inverse distances, so unreachable vertices add nothing.
+func WithClosenessHarmonic() CentralityOptionFunc {
+	return func(properties *CentralityProperties) {
+		properties.closeness = closenessHarmonic
+	}
+}
+
+// WithCentralityDijkstra sets the dijkstra options that are used to find the distances for the specified
+// centrality properties in the returned CentralityOptionFunc.
+func WithCentralityDijkstra(opts ...DijkstraOptionFunc) CentralityOptionFunc {
+	return func(properties *CentralityProperties) {
+		properties.dijkstraOptions = append(properties.dijkstraOptions, opts...)
+	}
+}
+
+// WithCentralityWorkers sets the number of vertices that are processed at the same time for the specified
+// centrality properties in the returned CentralityOptionFunc. By default, it's runtime.GOMAXPROCS(0).
+func WithCentralityWorkers(workers int) CentralityOptionFunc {
+	return func(properties *CentralityProperties) {
+		properties.workers = workers
+	}
+}
+
+// WithCentralityProgress sets the function that is called after each vertex has been processed for the
+// specified centrality properties in the returned CentralityOptionFunc. The function is never called
+// concurrently.
+func WithCentralityProgress(progress func(done, total int)) CentralityOptionFunc {
+	return func(properties *CentralityProperties) {
+		properties.progress = progress
+	}
+}
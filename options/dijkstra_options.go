@@ -23,9 +23,22 @@ package options
 // DijkstraOptionFunc represent an alias of function type that modifies the specified dijkstra properties.
 type DijkstraOptionFunc func(properties *DijkstraProperties)
 
+// DijkstraQueue represents the priority queue that the standard dijkstra
+// uses to select the next vertex.
+type DijkstraQueue int
+
+const (
+	BinaryHeapQueue  DijkstraQueue = iota // a binary heap, the default one.
+	PairingHeapQueue                      // a pairing heap.
+	DaryHeapQueue                         // a d-ary heap, see WithDijkstraDaryHeap.
+	BucketQueue                           // a bucket queue for small integer weights.
+)
+
 // DijkstraProperties represents the properties of an dijkstra.
 type DijkstraProperties struct {
 	useStandard bool
+	queue       DijkstraQueue
+	arity       int
 }
 
 // UseStandard set use standard to true
@@ -44,3 +57,43 @@ func WithDijkstraStandard() DijkstraOptionFunc {
 		properties.useStandard = true
 	}
 }
+
+// GetQueue return dj.queue from DijkstraProperties.
+func (dj DijkstraProperties) GetQueue() DijkstraQueue {
+	return dj.queue
+}
+
+// GetArity return dj.arity from DijkstraProperties.
+func (dj DijkstraProperties) GetArity() int {
+	return dj.arity
+}
+
+// WithDijkstraPairingHeap sets the standard algorithm with a pairing heap
+// as its priority queue in the returned DijkstraOptionFunc.
+func WithDijkstraPairingHeap() DijkstraOptionFunc {
+	return func(properties *DijkstraProperties) {
+		properties.useStandard = true
+		properties.queue = PairingHeapQueue
+	}
+}
+
+// WithDijkstraDaryHeap sets the standard algorithm with a d-ary heap as its
+// priority queue in the returned DijkstraOptionFunc. If d is lower than 2,
+// the heap is a binary heap.
+func WithDijkstraDaryHeap(d int) DijkstraOptionFunc {
+	return func(properties *DijkstraProperties) {
+		properties.useStandard = true
+		properties.queue = DaryHeapQueue
+		properties.arity = d
+	}
+}
+
+// WithDijkstraBucketQueue sets the standard algorithm with a bucket queue as
+// its priority queue in the returned DijkstraOptionFunc. It suits graphs with
+// small non-negative integer weights.
+func WithDijkstraBucketQueue() DijkstraOptionFunc {
+	return func(properties *DijkstraProperties) {
+		properties.useStandard = true
+		properties.queue = BucketQueue
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package options
+
+// DotOptionFunc represent an alias of function type that modifies the specified dot properties.
+type DotOptionFunc[T comparable] func(properties *DotProperties[T])
+
+// DotProperties represents the properties of a dot (graphviz) output.
+type DotProperties[T comparable] struct {
+	highlightedVertices map[T]bool
+	highlightedEdges    map[T]map[T]bool
+	highlightColor      string
+}
+
+// IsHighlightedVertex returns true if the vertex with the input label is highlighted in DotProperties.
+func (d DotProperties[T]) IsHighlightedVertex(label T) bool {
+	return d.highlightedVertices[label]
+}
+
+// IsHighlightedEdge returns true if the edge going from the 'from' label to the 'to' label
+// is highlighted in DotProperties.
+func (d DotProperties[T]) IsHighlightedEdge(from, to T) bool {
+	return d.highlightedEdges[from][to]
+}
+
+// GetHighlightColor returns d.highlightColor from DotProperties, which is "red" by default.
+func (d DotProperties[T]) GetHighlightColor() string {
+	if d.highlightColor == "" {
+		return "red"
+	}
+
+	return d.highlightColor
+}
+
+// WithDotHighlightPath sets the vertices and the edges along the path to be highlighted for the
+// specified dot properties in the returned DotOptionFunc. The labels must be in the path order.
+func WithDotHighlightPath[T comparable](labels ...T) DotOptionFunc[T] {
+	return func(properties *DotProperties[T]) {
+		WithDotHighlightVertices(labels...)(properties)
+
+		if properties.highlightedEdges == nil {
+			properties.highlightedEdges = make(map[T]map[T]bool)
+		}
+
+		for i := 1; i < len(labels); i++ {
+			if _, ok := properties.highlightedEdges[labels[i-1]]; !ok {
+				properties.highlightedEdges[labels[i-1]] = make(map[T]bool)
+			}
+
+			properties.highlightedEdges[labels[i-1]][labels[i]] = true
+		}
+	}
+}
+
+// WithDotHighlightVertices sets the vertices to be highlighted for the specified dot properties
+// in the returned DotOptionFunc.
+func WithDotHighlightVertices[T comparable](labels ...T) DotOptionFunc[T] {
+	return func(properties *DotProperties[T]) {
+		if properties.highlightedVertices == nil {
+			properties.highlightedVertices = make(map[T]bool)
+		}
+
+		for _, label := range labels {
+			properties.highlightedVertices[label] = true
+		}
+	}
+}
+
+// WithDotHighlightColor sets the color of the highlighted vertices and edges for the specified
+// dot properties in the returned DotOptionFunc.
+func WithDotHighlightColor[T comparable](color string) DotOptionFunc[T] {
+	return func(properties *DotProperties[T]) {
+		properties.highlightColor = color
+	}
+}
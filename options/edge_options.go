@@ -20,6 +20,8 @@
 
 package options
 
+import "maps"
+
 // EdgeOptionFunc represent an alias of function type that
 // modifies the specified edge properties.
 type EdgeOptionFunc func(properties *EdgeProperties)
@@ -27,6 +29,7 @@ type EdgeOptionFunc func(properties *EdgeProperties)
 // EdgeProperties represents the properties of an edge.
 type EdgeProperties struct {
 	weight float64
+	attrs  map[string]any
 }
 
 // Weight returns v.weight from VertexProperties
@@ -34,6 +37,18 @@ func (v EdgeProperties) Weight() float64 {
 	return v.weight
 }
 
+// Attr returns the attribute with the input key from EdgeProperties.
+// It returns 'false' if the attribute doesn't exist.
+func (v EdgeProperties) Attr(key string) (any, bool) {
+	value, ok := v.attrs[key]
+	return value, ok
+}
+
+// Attrs returns a copy of all attributes from EdgeProperties.
+func (v EdgeProperties) Attrs() map[string]any {
+	return maps.Clone(v.attrs)
+}
+
 // WithEdgeWeight sets the edge weight for the specified edge
 // properties in the returned EdgeOptionFunc.
 func WithEdgeWeight(weight float64) EdgeOptionFunc {
@@ -41,3 +56,16 @@ func WithEdgeWeight(weight float64) EdgeOptionFunc {
 		properties.weight = weight
 	}
 }
+
+// WithEdgeAttr sets the attribute with the input key for the specified edge
+// properties in the returned EdgeOptionFunc. The attributes can't be changed
+// once the edge has been created.
+func WithEdgeAttr(key string, value any) EdgeOptionFunc {
+	return func(properties *EdgeProperties) {
+		if properties.attrs == nil {
+			properties.attrs = make(map[string]any)
+		}
+
+		properties.attrs[key] = value
+	}
+}
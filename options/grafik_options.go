@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package options
+
+// GrafikOptionFunc represent an alias of function type that modifies the specified grafik properties.
+type GrafikOptionFunc func(properties *GrafikProperties)
+
+// GrafikProperties represents the properties of a grafik.
+type GrafikProperties struct {
+	isDirected          bool
+	isMultigraph        bool
+	isSelfLoopsRejected bool
+}
+
+// IsDirected returns g.isDirected from GrafikProperties.
+func (g GrafikProperties) IsDirected() bool {
+	return g.isDirected
+}
+
+// IsMultigraph returns g.isMultigraph from GrafikProperties.
+func (g GrafikProperties) IsMultigraph() bool {
+	return g.isMultigraph
+}
+
+// IsSelfLoopsRejected returns g.isSelfLoopsRejected from GrafikProperties.
+func (g GrafikProperties) IsSelfLoopsRejected() bool {
+	return g.isSelfLoopsRejected
+}
+
+// WithDirected sets the directed mode for the specified grafik properties in the returned GrafikOptionFunc.
+// In directed mode, edges are only created from the source vertex to the destination vertex.
+func WithDirected() GrafikOptionFunc {
+	return func(properties *GrafikProperties) {
+		properties.isDirected = true
+	}
+}
+
+// WithMultigraph sets the multigraph mode for the specified grafik properties in the returned GrafikOptionFunc.
+// In multigraph mode, several edges can connect the same vertices, and each of them has its own id.
+func WithMultigraph() GrafikOptionFunc {
+	return func(properties *GrafikProperties) {
+		properties.isMultigraph = true
+	}
+}
+
+// WithSelfLoopsRejected sets the graph to reject the edges from a vertex to itself for the specified
+// grafik properties in the returned GrafikOptionFunc. Otherwise, a self-loop is added once to the
+// neighbors of its vertex, and counts once as an incoming and once as an outgoing edge.
+func WithSelfLoopsRejected() GrafikOptionFunc {
+	return func(properties *GrafikProperties) {
+		properties.isSelfLoopsRejected = true
+	}
+}
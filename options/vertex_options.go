@@ -20,12 +20,15 @@
 
 package options
 
+import "maps"
+
 // VertexOptionFunc represent an alias of function type that modifies the specified vertex properties.
 type VertexOptionFunc func(properties *VertexProperties)
 
 // VertexProperties represents the properties of an edge.
 type VertexProperties struct {
 	weight float64
+	attrs  map[string]any
 }
 
 // Weight returns v.weight from VertexProperties
@@ -33,9 +36,34 @@ func (v VertexProperties) Weight() float64 {
 	return v.weight
 }
 
+// Attr returns the attribute with the input key from VertexProperties.
+// It returns 'false' if the attribute doesn't exist.
+func (v VertexProperties) Attr(key string) (any, bool) {
+	value, ok := v.attrs[key]
+	return value, ok
+}
+
+// Attrs returns a copy of all attributes from VertexProperties.
+func (v VertexProperties) Attrs() map[string]any {
+	return maps.Clone(v.attrs)
+}
+
 // WithVertexWeight sets the edge weight for the specified vertex properties in the returned VertexOptionFunc.
 func WithVertexWeight(weight float64) VertexOptionFunc {
 	return func(properties *VertexProperties) {
 		properties.weight = weight
 	}
 }
+
+// WithVertexAttr sets the attribute with the input key for the specified vertex
+// properties in the returned VertexOptionFunc. The attributes can't be changed
+// once the vertex has been created.
+func WithVertexAttr(key string, value any) VertexOptionFunc {
+	return func(properties *VertexProperties) {
+		if properties.attrs == nil {
+			properties.attrs = make(map[string]any)
+		}
+
+		properties.attrs[key] = value
+	}
+}
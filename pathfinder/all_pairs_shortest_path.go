@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"math"
+
+	"github.com/fitm-elite/grafik"
+)
+
+// AllPairsShortestPaths represents the shortest paths between every pair
+// of vertices in a graph. It keeps a distance matrix and a next-hop matrix
+// indexed by the position of each vertex label.
+type AllPairsShortestPaths[T comparable] struct {
+	graph  grafik.Grafik[T]
+	labels []T
+	index  map[T]int
+	dist   [][]float64
+	next   [][]int // the index of the vertex after i on the path from i to j, -1 if there is no path.
+}
+
+func newAllPairsShortestPaths[T comparable](g grafik.Grafik[T]) *AllPairsShortestPaths[T] {
+	vertices := g.GetAllVertices()
+
+	a := &AllPairsShortestPaths[T]{
+		graph:  g,
+		labels: make([]T, len(vertices)),
+		index:  make(map[T]int, len(vertices)),
+		dist:   make([][]float64, len(vertices)),
+		next:   make([][]int, len(vertices)),
+	}
+
+	for i, v := range vertices {
+		a.labels[i] = v.Label()
+		a.index[v.Label()] = i
+		a.dist[i] = make([]float64, len(vertices))
+		a.next[i] = make([]int, len(vertices))
+
+		for j := range vertices {
+			a.dist[i][j] = math.MaxFloat64
+			a.next[i][j] = -1
+		}
+
+		a.dist[i][i] = 0
+		a.next[i][i] = i
+	}
+
+	return a
+}
+
+// Labels returns the labels of all vertices.
+func (a *AllPairsShortestPaths[T]) Labels() []T {
+	labels := make([]T, len(a.labels))
+	copy(labels, a.labels)
+
+	return labels
+}
+
+// Distances returns a copy of the distance matrix keyed by the labels of
+// the 'from' and the 'to' vertices. Unreachable vertices have a distance
+// of math.MaxFloat64.
+func (a *AllPairsShortestPaths[T]) Distances() map[T]map[T]float64 {
+	distances := make(map[T]map[T]float64, len(a.labels))
+	for i, from := range a.labels {
+		distances[from] = make(map[T]float64, len(a.labels))
+		for j, to := range a.labels {
+			distances[from][to] = a.dist[i][j]
+		}
+	}
+
+	return distances
+}
+
+// Distance returns the distance from the 'from' vertex to the 'to' vertex.
+//
+// If the 'to' vertex is unreachable or any of the vertices doesn't exist,
+// returns math.MaxFloat64.
+func (a *AllPairsShortestPaths[T]) Distance(from, to T) float64 {
+	i, ok := a.index[from]
+	if !ok {
+		return math.MaxFloat64
+	}
+
+	j, ok := a.index[to]
+	if !ok {
+		return math.MaxFloat64
+	}
+
+	return a.dist[i][j]
+}
+
+// HasPath returns 'true' if the 'to' vertex can be reached from the
+// 'from' vertex.
+func (a *AllPairsShortestPaths[T]) HasPath(from, to T) bool {
+	return a.Distance(from, to) != math.MaxFloat64
+}
+
+// Path returns the shortest path from the 'from' vertex to the 'to' vertex,
+// reconstructed from the next-hop matrix.
+//
+// If any of the vertices doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If the 'to' vertex is unreachable, returns ErrNoPath.
+func (a *AllPairsShortestPaths[T]) Path(from, to T) (Path[T], error) {
+	i, ok := a.index[from]
+	if !ok {
+		return Path[T]{}, grafik.ErrVertexDoesNotExist
+	}
+
+	j, ok := a.index[to]
+	if !ok {
+		return Path[T]{}, grafik.ErrVertexDoesNotExist
+	}
+
+	if a.next[i][j] == -1 {
+		return Path[T]{}, ErrNoPath
+	}
+
+	labels := []T{from}
+	edges := make([]*grafik.Edge[T], 0)
+	for curr := i; curr != j; {
+		hop := a.next[curr][j]
+		edges = append(edges, a.graph.GetEdge(a.graph.GetVertexByLabel(a.labels[curr]), a.graph.GetVertexByLabel(a.labels[hop])))
+		labels = append(labels, a.labels[hop])
+		curr = hop
+	}
+
+	return Path[T]{labels: labels, edges: edges, cost: a.dist[i][j]}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	_ = g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vB, options.WithEdgeWeight(2))
+
+	a, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if len(a.Labels()) != 4 {
+		t.Errorf("Expected 4 labels, got %d", len(a.Labels()))
+	}
+
+	path, err := a.Path("A", "B")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual(path.Labels(), []string{"A", "C", "B"}) || path.Cost() != 3 || len(path.Edges()) != 2 {
+		t.Errorf("Expected path A C B with cost 3, got %v with cost %f", path.Labels(), path.Cost())
+	}
+
+	path, err = a.Path("C", "C")
+	if err != nil || !reflect.DeepEqual(path.Labels(), []string{"C"}) || len(path.Edges()) != 0 {
+		t.Errorf("Expected empty path to itself, but got %v, %v", path.Labels(), err)
+	}
+
+	if _, err = a.Path("B", "A"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Expected %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err = a.Path("A", "X"); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+		t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+	}
+
+	if a.HasPath("A", "D") || a.Distance("A", "D") != math.MaxFloat64 || a.Distance("X", "A") != math.MaxFloat64 {
+		t.Errorf("Expected D to be unreachable from A")
+	}
+
+	distances := a.Distances()
+	if distances["A"]["B"] != 3 || distances["C"]["B"] != 2 || distances["B"]["B"] != 0 {
+		t.Errorf("Expected distances A-B 3, C-B 2, B-B 0, got %v", distances)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"math"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+	"github.com/fitm-elite/grafik/queue"
+)
+
+// Heuristic estimates the cost of the cheapest path from the vertex with
+// the input label to the goal vertex. To find the shortest path, the
+// heuristic must never overestimate the cost.
+type Heuristic[T comparable] func(label, goal T) float64
+
+// Coordinates returns the x and y coordinates of the vertex with the input
+// label, or 'false' if the vertex has no coordinates.
+type Coordinates[T comparable] func(label T) (x, y float64, ok bool)
+
+// EuclideanHeuristic returns a heuristic of the straight-line distance
+// between the coordinates of the vertices. It suits graphs whose edge
+// weights are at least the distances between their vertices.
+//
+// If any of the vertices has no coordinates, the heuristic estimates 0.
+func EuclideanHeuristic[T comparable](coordinates Coordinates[T]) Heuristic[T] {
+	return func(label, goal T) float64 {
+		dx, dy, ok := delta(coordinates, label, goal)
+		if !ok {
+			return 0
+		}
+
+		return math.Hypot(dx, dy)
+	}
+}
+
+// ManhattanHeuristic returns a heuristic of the sum of the horizontal and
+// vertical distances between the coordinates of the vertices. It suits
+// grid graphs without diagonal edges.
+//
+// If any of the vertices has no coordinates, the heuristic estimates 0.
+func ManhattanHeuristic[T comparable](coordinates Coordinates[T]) Heuristic[T] {
+	return func(label, goal T) float64 {
+		dx, dy, ok := delta(coordinates, label, goal)
+		if !ok {
+			return 0
+		}
+
+		return math.Abs(dx) + math.Abs(dy)
+	}
+}
+
+// AttrCoordinates returns the coordinates stored in the vertex attributes
+// with the input keys, such as the ones added by options.WithVertexAttr("x", 1.5).
+// The attribute values can be of any integer or floating point type.
+func AttrCoordinates[T comparable](g grafik.Grafik[T], xKey, yKey string) Coordinates[T] {
+	return func(label T) (float64, float64, bool) {
+		v := g.GetVertexByLabel(label)
+		if v == nil {
+			return 0, 0, false
+		}
+
+		x, xOk := v.Attr(xKey)
+		y, yOk := v.Attr(yKey)
+		if !xOk || !yOk {
+			return 0, 0, false
+		}
+
+		fx, xOk := toFloat(x)
+		fy, yOk := toFloat(y)
+
+		return fx, fy, xOk && yOk
+	}
+}
+
+// AStar finds the shortest path from the start vertex to the goal vertex
+// using the A* search algorithm. The heuristic guides the search towards
+// the goal, so fewer vertices are explored than with Dijkstra's algorithm.
+// The search stops as soon as the goal vertex is reached.
+//
+// The edge weights must not be negative. If the heuristic is nil, it
+// behaves as Dijkstra's algorithm. The options select the priority queue
+// of the search, as they do for Dijkstra.
+//
+// If any of the vertices doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If there is no path between the vertices, returns ErrNoPath.
+func AStar[T comparable](g grafik.Grafik[T], start, goal T, heuristic Heuristic[T], opts ...options.DijkstraOptionFunc) (Path[T], error) {
+	g = grafik.Snapshot(g)
+
+	var properties options.DijkstraProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	if g.GetVertexByLabel(start) == nil || g.GetVertexByLabel(goal) == nil {
+		return Path[T]{}, grafik.ErrVertexDoesNotExist
+	}
+
+	if heuristic == nil {
+		heuristic = func(T, T) float64 { return 0 }
+	}
+
+	tree := newShortestPathTree(g, start)
+	tree.dist[start] = 0
+
+	// the priority of each vertex is its distance plus the estimated cost to the goal.
+	estimates := map[T]float64{start: heuristic(start, goal)}
+
+	pq := queue.NewVertexQueue[T](properties)
+	pq.Push(queue.NewVertexWithPriority(g.GetVertexByLabel(start), estimates[start]))
+
+	for pq.Len() > 0 {
+		u := pq.Pop().Vertex()
+		if u.Label() == goal {
+			return tree.PathTo(goal)
+		}
+
+		for _, neighbor := range u.Neighbors() {
+			alt := tree.dist[u.Label()] + g.GetEdge(u, neighbor).Weight()
+			if dist, ok := tree.dist[neighbor.Label()]; ok && alt >= dist {
+				continue
+			}
+
+			if _, ok := estimates[neighbor.Label()]; !ok {
+				estimates[neighbor.Label()] = heuristic(neighbor.Label(), goal)
+			}
+
+			tree.dist[neighbor.Label()] = alt
+			tree.previous[neighbor.Label()] = u.Label()
+			if !pq.DecreaseKey(neighbor.Label(), alt+estimates[neighbor.Label()]) {
+				pq.Push(queue.NewVertexWithPriority(neighbor, alt+estimates[neighbor.Label()]))
+			}
+		}
+	}
+
+	return Path[T]{}, ErrNoPath
+}
+
+// delta returns the differences between the coordinates of the vertices.
+func delta[T comparable](coordinates Coordinates[T], label, goal T) (float64, float64, bool) {
+	x1, y1, ok := coordinates(label)
+	if !ok {
+		return 0, 0, false
+	}
+
+	x2, y2, ok := coordinates(goal)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return x2 - x1, y2 - y1, true
+}
+
+// toFloat converts an integer or floating point value to float64.
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+// newGridGrafik returns a grid graph of size x size vertices labeled "x,y",
+// with the coordinates as vertex attributes and edges of weight 1.
+func newGridGrafik(size int) grafik.Grafik[string] {
+	g := grafik.New[string]()
+
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			g.AddVertexByLabel(fmt.Sprintf("%d,%d", x, y), options.WithVertexAttr("x", x), options.WithVertexAttr("y", y))
+		}
+	}
+
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			v := g.GetVertexByLabel(fmt.Sprintf("%d,%d", x, y))
+			if x+1 < size {
+				_, _ = g.AddEdge(v, g.GetVertexByLabel(fmt.Sprintf("%d,%d", x+1, y)), options.WithEdgeWeight(1))
+			}
+
+			if y+1 < size {
+				_, _ = g.AddEdge(v, g.GetVertexByLabel(fmt.Sprintf("%d,%d", x, y+1)), options.WithEdgeWeight(1))
+			}
+		}
+	}
+
+	return g
+}
+
+func TestAStar(t *testing.T) {
+	g := newGridGrafik(10)
+	coordinates := AttrCoordinates(g, "x", "y")
+
+	for _, heuristic := range []Heuristic[string]{nil, EuclideanHeuristic(coordinates), ManhattanHeuristic(coordinates)} {
+		path, err := AStar(g, "0,0", "9,9", heuristic)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if path.Cost() != 18 {
+			t.Errorf("Expected path cost to be 18, got %f", path.Cost())
+		}
+
+		if len(path.Labels()) != 19 || len(path.Edges()) != 18 {
+			t.Errorf("Expected 19 labels and 18 edges, got %d and %d", len(path.Labels()), len(path.Edges()))
+		}
+	}
+}
+
+func TestAStarQueues(t *testing.T) {
+	g := newGridGrafik(10)
+	manhattan := ManhattanHeuristic(AttrCoordinates(g, "x", "y"))
+
+	for name, opt := range dijkstraQueues() {
+		path, err := AStar(g, "0,0", "9,9", manhattan, opt)
+		if err != nil || path.Cost() != 18 {
+			t.Errorf("Expected %s path cost to be 18, got %f, %v", name, path.Cost(), err)
+		}
+	}
+}
+
+func TestAStarEarlyExit(t *testing.T) {
+	g := newGridGrafik(10)
+	manhattan := ManhattanHeuristic(AttrCoordinates(g, "x", "y"))
+
+	explored := make(map[string]bool)
+	heuristic := func(label, goal string) float64 {
+		explored[label] = true
+		return manhattan(label, goal)
+	}
+
+	path, err := AStar(g, "0,0", "0,3", heuristic)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := []string{"0,0", "0,1", "0,2", "0,3"}
+	if !reflect.DeepEqual(path.Labels(), expected) {
+		t.Errorf("Expected path %v, got %v", expected, path.Labels())
+	}
+
+	if len(explored) > 10 {
+		t.Errorf("Expected at most 10 explored vertices, got %d", len(explored))
+	}
+}
+
+func TestAStarErrors(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+
+	if _, err := AStar(g, "B", "A", nil); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Expected %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err := AStar(g, "A", "X", nil); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+		t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+	}
+
+	path, err := AStar(g, "A", "A", nil)
+	if err != nil || path.Cost() != 0 || len(path.Labels()) != 1 {
+		t.Errorf("Expected empty path to itself, but got %v, %v", path.Labels(), err)
+	}
+}
+
+func TestHeuristics(t *testing.T) {
+	coordinates := func(label string) (float64, float64, bool) {
+		switch label {
+		case "A":
+			return 0, 0, true
+		case "B":
+			return 3, 4, true
+		}
+
+		return 0, 0, false
+	}
+
+	if d := EuclideanHeuristic(coordinates)("A", "B"); d != 5 {
+		t.Errorf("Expected euclidean distance 5, got %f", d)
+	}
+
+	if d := ManhattanHeuristic(coordinates)("B", "A"); d != 7 {
+		t.Errorf("Expected manhattan distance 7, got %f", d)
+	}
+
+	if d := EuclideanHeuristic(coordinates)("A", "X"); d != 0 {
+		t.Errorf("Expected 0 without coordinates, got %f", d)
+	}
+
+	g := grafik.New[string]()
+	g.AddVertexByLabel("A", options.WithVertexAttr("x", float32(1.5)), options.WithVertexAttr("y", int64(2)))
+	g.AddVertexByLabel("B", options.WithVertexAttr("x", "left"), options.WithVertexAttr("y", 1))
+
+	if x, y, ok := AttrCoordinates(g, "x", "y")("A"); !ok || x != 1.5 || y != 2 {
+		t.Errorf("Expected coordinates (1.5, 2), got (%f, %f, %t)", x, y, ok)
+	}
+
+	if _, _, ok := AttrCoordinates(g, "x", "y")("B"); ok {
+		t.Errorf("Expected no coordinates for non-numeric attribute")
+	}
+
+	if _, _, ok := AttrCoordinates(g, "x", "y")("X"); ok {
+		t.Errorf("Expected no coordinates for missing vertex")
+	}
+}
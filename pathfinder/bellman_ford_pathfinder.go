@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/fitm-elite/grafik"
+)
+
+var ErrNegativeCycle = errors.New("negative cycle detected")
+
+// NegativeCycleError is returned when a negative cycle can be reached from
+// the start vertex. It carries the labels of the vertices on the cycle, in
+// the order of the edges. The first vertex is not repeated at the end.
+//
+// It matches ErrNegativeCycle when using errors.Is.
+type NegativeCycleError[T comparable] struct {
+	Cycle []T
+}
+
+// Error returns the error message including the cycle.
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("%s: %v", ErrNegativeCycle, e.Cycle)
+}
+
+// Is reports whether the target is ErrNegativeCycle.
+func (e *NegativeCycleError[T]) Is(target error) bool {
+	return target == ErrNegativeCycle
+}
+
+// BellmanFord finds the shortest paths from the start vertex to all other
+// vertices using the Bellman-Ford algorithm. Unlike Dijkstra, it supports
+// negative edge weights.
+//
+// The time complexity of the Bellman-Ford algorithm is O(V*E).
+//
+// It returns the shortest path tree, which keeps the distances and the
+// predecessor of each reached vertex.
+//
+// If the start vertex doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If a negative cycle can be reached from the start vertex, returns
+// *NegativeCycleError. Note that in undirected graph any negative edge
+// forms a negative cycle.
+func BellmanFord[T comparable](g grafik.Grafik[T], start T) (*ShortestPathTree[T], error) {
+	g = grafik.Snapshot(g)
+
+	if g.GetVertexByLabel(start) == nil {
+		return nil, grafik.ErrVertexDoesNotExist
+	}
+
+	tree := newShortestPathTree(g, start)
+	dist := tree.dist
+
+	vertices := g.GetAllVertices()
+	edges := make([]*grafik.Edge[T], 0, len(vertices))
+	for _, v := range vertices {
+		dist[v.Label()] = math.MaxFloat64
+		for _, neighbor := range v.Neighbors() {
+			edges = append(edges, g.GetEdge(v, neighbor))
+		}
+	}
+
+	dist[start] = 0
+
+	// relax every edge V-1 times, stop early if nothing has changed.
+	for i := 1; i < len(vertices); i++ {
+		updated := false
+		for _, edge := range edges {
+			if relax(tree, edge) {
+				updated = true
+			}
+		}
+
+		if !updated {
+			return tree, nil
+		}
+	}
+
+	// any edge that can still be relaxed is part of, or reachable from,
+	// a negative cycle.
+	for _, edge := range edges {
+		if relax(tree, edge) {
+			return nil, &NegativeCycleError[T]{Cycle: findCycle(tree, edge.Destination().Label(), len(vertices))}
+		}
+	}
+
+	return tree, nil
+}
+
+// relax updates the distance and the predecessor of the edge destination,
+// if the path through the edge source is shorter.
+func relax[T comparable](tree *ShortestPathTree[T], edge *grafik.Edge[T]) bool {
+	from, to := edge.Source().Label(), edge.Destination().Label()
+	if tree.dist[from] == math.MaxFloat64 {
+		return false
+	}
+
+	if alt := tree.dist[from] + edge.Weight(); alt < tree.dist[to] {
+		tree.dist[to] = alt
+		tree.previous[to] = from
+
+		return true
+	}
+
+	return false
+}
+
+// findCycle walks back the predecessors from the input vertex to find
+// the negative cycle.
+func findCycle[T comparable](tree *ShortestPathTree[T], label T, size int) []T {
+	// after walking back V times, the vertex is guaranteed to be on the cycle.
+	for i := 0; i < size; i++ {
+		label = tree.previous[label]
+	}
+
+	cycle := []T{label}
+	for curr := tree.previous[label]; curr != label; curr = tree.previous[curr] {
+		cycle = append(cycle, curr)
+	}
+
+	// the cycle is collected backwards, so reverse it.
+	slices.Reverse(cycle)
+
+	return cycle
+}
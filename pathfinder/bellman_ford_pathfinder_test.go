@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestBellmanFord(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	_ = g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vC, vB, options.WithEdgeWeight(-3))
+	_, _ = g.AddEdge(vB, vD, options.WithEdgeWeight(2))
+
+	// use not existing vertex
+	if _, err := BellmanFord(g, "X"); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+		t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+	}
+
+	tree, err := BellmanFord(g, "A")
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	expected := map[string]float64{"A": 0, "B": 2, "C": 5, "D": 4}
+	for label, dist := range expected {
+		if tree.Distance(label) != dist {
+			t.Errorf("Expected distance from A to %s to be %f, got %f", label, dist, tree.Distance(label))
+		}
+	}
+
+	if tree.HasPathTo("E") {
+		t.Error("Expected E to be unreachable")
+	}
+
+	predecessors := tree.Predecessors()
+	if !reflect.DeepEqual(predecessors, map[string]string{"B": "C", "C": "A", "D": "B"}) {
+		t.Errorf("Expected predecessors B:C, C:A, D:B, got %v", predecessors)
+	}
+
+	path, _ := tree.PathTo("D")
+	if !reflect.DeepEqual(path.Labels(), []string{"A", "C", "B", "D"}) {
+		t.Errorf("Expected path A C B D, got %v", path.Labels())
+	}
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vD, options.WithEdgeWeight(-2))
+	_, _ = g.AddEdge(vD, vB, options.WithEdgeWeight(-1))
+	_, _ = g.AddEdge(vE, vA, options.WithEdgeWeight(1))
+
+	_, err := BellmanFord(g, "A")
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected %s, but got %v", ErrNegativeCycle, err)
+	}
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected *NegativeCycleError, but got %T", err)
+	}
+
+	if len(cycleErr.Cycle) != 3 {
+		t.Fatalf("Expected a cycle of 3 vertices, got %v", cycleErr.Cycle)
+	}
+
+	// the cycle must follow the edge direction.
+	for i, label := range cycleErr.Cycle {
+		from := g.GetVertexByLabel(label)
+		to := g.GetVertexByLabel(cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)])
+		if !g.ContainsEdge(from, to) {
+			t.Errorf("Expected edge %s -> %s in cycle %v", from.Label(), to.Label(), cycleErr.Cycle)
+		}
+	}
+
+	// E reaches the cycle through A.
+	if _, err = BellmanFord(g, "E"); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected %s, but got %v", ErrNegativeCycle, err)
+	}
+}
+
+func TestBellmanFordUnreachableNegativeCycle(t *testing.T) {
+	g := grafik.New[int](options.WithDirected())
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+
+	_, _ = g.AddEdge(v1, v2, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(v3, v1, options.WithEdgeWeight(-5))
+	_, _ = g.AddEdge(v1, v3, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(v2, v1, options.WithEdgeWeight(1))
+
+	// 1 -> 3 -> 1 is negative and reachable.
+	if _, err := BellmanFord(g, 2); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected %s, but got %v", ErrNegativeCycle, err)
+	}
+
+	h := grafik.New[int](options.WithDirected())
+	v1 = h.AddVertexByLabel(1)
+	v2 = h.AddVertexByLabel(2)
+	v3 = h.AddVertexByLabel(3)
+
+	_, _ = h.AddEdge(v1, v2, options.WithEdgeWeight(1))
+	_, _ = h.AddEdge(v2, v3, options.WithEdgeWeight(-1))
+	_, _ = h.AddEdge(v3, v2, options.WithEdgeWeight(-1))
+
+	// 2 <-> 3 is negative, but it can't be reached from 4.
+	h.AddVertex(grafik.NewVertex(4))
+	if _, err := BellmanFord(h, 4); err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"math"
+	"slices"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+	"github.com/fitm-elite/grafik/queue"
+)
+
+// dijkstraSearch represents one direction of a bidirectional Dijkstra's
+// search. The backward search follows the edges in reverse, so its
+// previous vertex of each reached vertex is the next one towards the target.
+type dijkstraSearch[T comparable] struct {
+	dist     map[T]float64
+	previous map[T]T
+	pq       queue.VertexQueue[T]
+	backward bool
+}
+
+func newDijkstraSearch[T comparable](source *grafik.Vertex[T], backward bool, properties options.DijkstraProperties) *dijkstraSearch[T] {
+	s := &dijkstraSearch[T]{
+		dist:     map[T]float64{source.Label(): 0},
+		previous: make(map[T]T),
+		pq:       queue.NewVertexQueue[T](properties),
+		backward: backward,
+	}
+
+	s.pq.Push(queue.NewVertexWithPriority(source, 0))
+
+	return s
+}
+
+// top returns the smallest tentative distance in the queue.
+func (s *dijkstraSearch[T]) top() float64 {
+	if s.pq.Len() == 0 {
+		return math.MaxFloat64
+	}
+
+	return s.pq.Peek().Priority()
+}
+
+// BidirectionalDijkstra returns the shortest path from the 'from' vertex to
+// the 'to' vertex, including the ordered vertex labels, the edges used and
+// the total cost. It searches forward from the 'from' vertex and backward
+// from the 'to' vertex at the same time, and stops once the searches meet
+// on the shortest path, which usually settles far fewer vertices than
+// DijkstraPath on large graphs.
+//
+// The edge weights must not be negative. The options select the priority
+// queue of both searches, as they do for Dijkstra.
+//
+// If any of the vertices doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If there is no path between the vertices, returns ErrNoPath.
+func BidirectionalDijkstra[T comparable](g grafik.Grafik[T], from, to T, opts ...options.DijkstraOptionFunc) (Path[T], error) {
+	g = grafik.Snapshot(g)
+
+	var properties options.DijkstraProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	source, target := g.GetVertexByLabel(from), g.GetVertexByLabel(to)
+	if source == nil || target == nil {
+		return Path[T]{}, grafik.ErrVertexDoesNotExist
+	}
+
+	forward := newDijkstraSearch(source, false, properties)
+	backward := newDijkstraSearch(target, true, properties)
+
+	// best is the cost of the shortest path found so far, which goes
+	// through the meeting vertex.
+	best, meeting, met := math.MaxFloat64, from, from == to
+	if met {
+		best = 0
+	}
+
+	for {
+		forwardTop, backwardTop := forward.top(), backward.top()
+		if forwardTop == math.MaxFloat64 || backwardTop == math.MaxFloat64 || forwardTop+backwardTop >= best {
+			break
+		}
+
+		// expand the search with the smaller frontier distance.
+		search, other := forward, backward
+		if backwardTop < forwardTop {
+			search, other = backward, forward
+		}
+
+		u := search.pq.Pop().Vertex()
+
+		neighbors := u.Neighbors()
+		if search.backward {
+			neighbors = u.InNeighbors()
+		}
+
+		for _, v := range neighbors {
+			edge := g.GetEdge(u, v)
+			if search.backward {
+				edge = g.GetEdge(v, u)
+			}
+
+			alt := search.dist[u.Label()] + edge.Weight()
+			if dist, ok := search.dist[v.Label()]; !ok || alt < dist {
+				search.dist[v.Label()] = alt
+				search.previous[v.Label()] = u.Label()
+				if !search.pq.DecreaseKey(v.Label(), alt) {
+					search.pq.Push(queue.NewVertexWithPriority(v, alt))
+				}
+			}
+
+			if dist, ok := other.dist[v.Label()]; ok && search.dist[v.Label()]+dist < best {
+				best, meeting, met = search.dist[v.Label()]+dist, v.Label(), true
+			}
+		}
+	}
+
+	if !met {
+		return Path[T]{}, ErrNoPath
+	}
+
+	labels := []T{meeting}
+	for curr := meeting; curr != from; {
+		curr = forward.previous[curr]
+		labels = append(labels, curr)
+	}
+
+	// the forward labels are collected from the meeting vertex, so reverse them.
+	slices.Reverse(labels)
+
+	for curr := meeting; curr != to; {
+		curr = backward.previous[curr]
+		labels = append(labels, curr)
+	}
+
+	edges := make([]*grafik.Edge[T], 0, len(labels)-1)
+	for i := 1; i < len(labels); i++ {
+		edges = append(edges, g.GetEdge(g.GetVertexByLabel(labels[i-1]), g.GetVertexByLabel(labels[i])))
+	}
+
+	return Path[T]{labels: labels, edges: edges, cost: best}, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/internal/testgrafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, options.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vD, vE, options.WithEdgeWeight(1))
+
+	path, err := BidirectionalDijkstra(g, "A", "E")
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := []string{"A", "C", "B", "D", "E"}
+	if !reflect.DeepEqual(path.Labels(), expected) {
+		t.Errorf("Expected path %v, got %v", expected, path.Labels())
+	}
+
+	if path.Cost() != 5 {
+		t.Errorf("Expected path cost to be 5, got %f", path.Cost())
+	}
+
+	for i, edge := range path.Edges() {
+		if edge.Source().Label() != expected[i] || edge.Destination().Label() != expected[i+1] {
+			t.Errorf("Expected edge %s -> %s, got %s -> %s", expected[i], expected[i+1], edge.Source().Label(), edge.Destination().Label())
+		}
+	}
+
+	if _, err = BidirectionalDijkstra(g, "E", "A"); !errors.Is(err, ErrNoPath) {
+		t.Errorf("Expected %s, but got %v", ErrNoPath, err)
+	}
+
+	if _, err = BidirectionalDijkstra(g, "A", "X"); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+		t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+	}
+
+	path, err = BidirectionalDijkstra(g, "B", "B")
+	if err != nil || path.Cost() != 0 || !reflect.DeepEqual(path.Labels(), []string{"B"}) {
+		t.Errorf("Expected empty path to itself, but got %v, %v", path.Labels(), err)
+	}
+}
+
+func TestBidirectionalDijkstraMatchesDijkstraPath(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithDirected()}} {
+		g := grafik.New[int](opts...)
+		for i := 0; i < 60; i++ {
+			g.AddVertexByLabel(i)
+		}
+
+		for i := 0; i < 150; i++ {
+			from, to := g.GetVertexByLabel(rnd.Intn(60)), g.GetVertexByLabel(rnd.Intn(60))
+			if from != to {
+				_, _ = g.AddEdge(from, to, options.WithEdgeWeight(float64(rnd.Intn(10))))
+			}
+		}
+
+		for i := 0; i < 100; i++ {
+			from, to := rnd.Intn(60), rnd.Intn(60)
+
+			expected, expectedErr := DijkstraPath(g, from, to)
+			path, err := BidirectionalDijkstra(g, from, to)
+			if !errors.Is(err, expectedErr) {
+				t.Fatalf("Expected %v from %d to %d, but got %v", expectedErr, from, to, err)
+			}
+
+			if path.Cost() != expected.Cost() {
+				t.Errorf("Expected path cost from %d to %d to be %f, got %f", from, to, expected.Cost(), path.Cost())
+			}
+
+			cost := 0.0
+			for _, edge := range path.Edges() {
+				cost += edge.Weight()
+			}
+
+			if cost != path.Cost() {
+				t.Errorf("Expected sum of edge weights to be %f, got %f", path.Cost(), cost)
+			}
+		}
+	}
+}
+
+func TestBidirectionalDijkstraQueues(t *testing.T) {
+	g, err := testgrafik.NewHub(200, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	expected := Dijkstra(g, 0)
+
+	for name, opt := range dijkstraQueues() {
+		for _, to := range []int{50, 150, 199} {
+			path, err := BidirectionalDijkstra(g, 0, to, opt)
+			if err != nil || path.Cost() != expected[to] {
+				t.Errorf("Expected %s path to %d of weight %f, got %v, %v", name, to, expected[to], path.Cost(), err)
+			}
+		}
+	}
+}
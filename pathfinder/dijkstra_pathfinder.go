@@ -59,51 +59,124 @@ func newDijkstraVertex[T comparable](label T) *dijkstraVertex[T] {
 // It returns the shortest distances from the starting vertex to all other vertices
 // in the graph.
 func Dijkstra[T comparable](g grafik.Grafik[T], start T, opts ...options.DijkstraOptionFunc) map[T]float64 {
+	tree, err := DijkstraTree(g, start, opts...)
+	if err != nil {
+		return make(map[T]float64)
+	}
+
+	return tree.dist
+}
+
+// DijkstraTree runs Dijkstra's algorithm from the start vertex and returns
+// the whole shortest path tree, which keeps both the distances and the
+// previous vertex of each reached vertex.
+//
+// If the start vertex doesn't exist, returns grafik.ErrVertexDoesNotExist.
+func DijkstraTree[T comparable](g grafik.Grafik[T], start T, opts ...options.DijkstraOptionFunc) (*ShortestPathTree[T], error) {
+	g = grafik.Snapshot(g)
+
+	var properties options.DijkstraProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	if g.GetVertexByLabel(start) == nil {
+		return nil, grafik.ErrVertexDoesNotExist
+	}
+
+	return dijkstra(g, start, properties, nil), nil
+}
+
+// DijkstraPath returns the shortest path from the 'from' vertex to the 'to'
+// vertex, including the ordered vertex labels, the edges used and the total cost.
+// It stops as soon as the 'to' vertex is settled, instead of settling every
+// vertex of the graph.
+//
+// If any of the vertices doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If there is no path between the vertices, returns ErrNoPath.
+func DijkstraPath[T comparable](g grafik.Grafik[T], from, to T, opts ...options.DijkstraOptionFunc) (Path[T], error) {
+	g = grafik.Snapshot(g)
+
 	var properties options.DijkstraProperties
 	for _, opt := range opts {
 		opt(&properties)
 	}
 
-	dist := make(map[T]float64)
+	if g.GetVertexByLabel(from) == nil || g.GetVertexByLabel(to) == nil {
+		return Path[T]{}, grafik.ErrVertexDoesNotExist
+	}
+
+	tree := dijkstra(g, from, properties, func(label T) bool {
+		return label == to
+	})
 
-	startVertex := g.GetVertexByLabel(start)
-	if startVertex == nil {
-		return dist
+	return tree.PathTo(to)
+}
+
+// dijkstra runs the Dijkstra's algorithm selected by the properties. If
+// stop isn't nil, it stops once stop returns 'true' for a settled vertex,
+// so only the distances of the settled vertices are final.
+func dijkstra[T comparable](g grafik.Grafik[T], start T, properties options.DijkstraProperties, stop func(label T) bool) *ShortestPathTree[T] {
+	if stop == nil {
+		stop = func(T) bool { return false }
 	}
 
-	// useStandard checker
 	if !properties.GetUseStandard() {
-		vertices := g.GetAllVertices()
+		return simpleDijkstra(g, start, stop)
+	}
+
+	return standardDijkstra(g, start, queue.NewVertexQueue[T](properties), stop)
+}
+
+// simpleDijkstra selects the unvisited vertex with the smallest tentative
+// distance using linear search.
+func simpleDijkstra[T comparable](g grafik.Grafik[T], start T, stop func(label T) bool) *ShortestPathTree[T] {
+	tree := newShortestPathTree(g, start)
+	dist := tree.dist
+
+	vertices := g.GetAllVertices()
+	for _, v := range vertices {
+		dist[v.Label()] = math.MaxFloat64
+	}
+
+	dist[start] = 0
+	visited := make(map[T]bool)
+	for len(visited) < len(vertices) {
+		var u *grafik.Vertex[T]
 		for _, v := range vertices {
-			dist[v.Label()] = math.MaxFloat64
+			if !visited[v.Label()] && (u == nil || dist[v.Label()] < dist[u.Label()]) {
+				u = v
+			}
 		}
 
-		dist[start] = 0
-		visited := make(map[T]bool)
-		for len(visited) < len(vertices) {
-			var u *grafik.Vertex[T]
-			for _, v := range vertices {
-				if !visited[v.Label()] && (u == nil || dist[v.Label()] < dist[u.Label()]) {
-					u = v
-				}
-			}
+		// the rest of the vertices are unreachable.
+		if dist[u.Label()] == math.MaxFloat64 {
+			break
+		}
 
-			visited[u.Label()] = true
+		visited[u.Label()] = true
+		if stop(u.Label()) {
+			break
+		}
 
-			neighbors := u.Neighbors()
-			for _, neighbor := range neighbors {
-				edge := g.GetEdge(u, neighbor)
-				if alt := dist[u.Label()] + edge.Weight(); alt < dist[edge.Destination().Label()] {
-					dist[edge.Destination().Label()] = alt
-				}
+		neighbors := u.Neighbors()
+		for _, neighbor := range neighbors {
+			edge := g.GetEdge(u, neighbor)
+			if alt := dist[u.Label()] + edge.Weight(); alt < dist[edge.Destination().Label()] {
+				dist[edge.Destination().Label()] = alt
+				tree.previous[edge.Destination().Label()] = u.Label()
 			}
 		}
-
-		return dist
 	}
 
-	// Initialize the heap and the visited map
-	pq := queue.NewVertexPriorityQueue[T]()
+	return tree
+}
+
+// standardDijkstra uses the input priority queue to select the unvisited
+// vertex with the smallest tentative distance. Each vertex is queued at
+// most once, and its priority is decreased when a shorter path is found.
+func standardDijkstra[T comparable](g grafik.Grafik[T], start T, pq queue.VertexQueue[T], stop func(label T) bool) *ShortestPathTree[T] {
+	// Initialize the visited map
 	visited := make(map[T]bool)
 
 	// Initialize the start vertex
@@ -123,6 +196,9 @@ func Dijkstra[T comparable](g grafik.Grafik[T], start T, opts ...options.Dijkstr
 		// Extract the vertex with the smallest tentative distance from the heap
 		curr := pq.Pop()
 		visited[curr.Vertex().Label()] = true
+		if stop(curr.Vertex().Label()) {
+			break
+		}
 
 		// Update the distances of its neighbors
 		neighbors := curr.Vertex().Neighbors()
@@ -133,17 +209,24 @@ func Dijkstra[T comparable](g grafik.Grafik[T], start T, opts ...options.Dijkstr
 				if newDist < neighbor.dist {
 					neighbor.dist = newDist
 					neighbor.previous = curr.Vertex().Label()
-					pq.Push(queue.NewVertexWithPriority(neighbors[i], dVertices[v.Label()].dist))
+
+					// lower the priority of the queued vertex, instead of queueing it twice.
+					if !pq.DecreaseKey(v.Label(), newDist) {
+						pq.Push(queue.NewVertexWithPriority(neighbors[i], newDist))
+					}
 				}
 			}
 		}
 	}
 
-	// Return the distances from the start vertex to each other vertex
-	distances := make(map[T]float64)
+	// Collect the distances and the previous vertices from the start vertex
+	tree := newShortestPathTree(g, start)
 	for _, v := range dVertices {
-		distances[v.label] = v.dist
+		tree.dist[v.label] = v.dist
+		if v.label != start && v.dist != math.MaxFloat64 {
+			tree.previous[v.label] = v.previous
+		}
 	}
 
-	return distances
+	return tree
 }
@@ -21,9 +21,12 @@
 package pathfinder
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/internal/testgrafik"
 	"github.com/fitm-elite/grafik/options"
 )
 
@@ -98,3 +101,287 @@ func TestStandardDijkstra(t *testing.T) {
 		t.Errorf("Expected distance from 1 to %d to be 6, got %f", v4.Label(), dist[v4.Label()])
 	}
 }
+
+func TestDijkstraOfDirected(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vA, options.WithEdgeWeight(1))
+
+	for _, dist := range []map[string]float64{
+		Dijkstra(g, "A"),
+		Dijkstra(g, "A", options.WithDijkstraStandard()),
+	} {
+		if dist[vB.Label()] != 1 {
+			t.Errorf("Expected distance from A to %s to be 1, got %f", vB.Label(), dist[vB.Label()])
+		}
+		if dist[vC.Label()] != 2 {
+			t.Errorf("Expected distance from A to %s to be 2, got %f", vC.Label(), dist[vC.Label()])
+		}
+	}
+
+	// B can only go forward to C and then back to A.
+	dist := Dijkstra(g, "B", options.WithDijkstraStandard())
+	if dist[vA.Label()] != 2 {
+		t.Errorf("Expected distance from B to %s to be 2, got %f", vA.Label(), dist[vA.Label()])
+	}
+}
+
+func TestDijkstraPath(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	_ = g.AddVertexByLabel("E")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vC, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vD, options.WithEdgeWeight(2))
+
+	for _, opts := range [][]options.DijkstraOptionFunc{nil, {options.WithDijkstraStandard()}} {
+		path, err := DijkstraPath(g, "A", "D", opts...)
+		if err != nil {
+			t.Errorf("Expected no error, but got %s", err)
+		}
+
+		expected := []string{"A", "C", "B", "D"}
+		if !reflect.DeepEqual(path.Labels(), expected) {
+			t.Errorf("Expected path %v, got %v", expected, path.Labels())
+		}
+
+		if path.Cost() != 4 {
+			t.Errorf("Expected path cost to be 4, got %f", path.Cost())
+		}
+
+		if len(path.Edges()) != 3 {
+			t.Errorf("Expected 3 edges, got %d", len(path.Edges()))
+		}
+
+		for i, edge := range path.Edges() {
+			if edge.Source().Label() != expected[i] || edge.Destination().Label() != expected[i+1] {
+				t.Errorf("Expected edge %s -> %s, got %s -> %s", expected[i], expected[i+1], edge.Source().Label(), edge.Destination().Label())
+			}
+		}
+
+		if _, err = DijkstraPath(g, "A", "E", opts...); !errors.Is(err, ErrNoPath) {
+			t.Errorf("Expected %s, but got %v", ErrNoPath, err)
+		}
+
+		if _, err = DijkstraPath(g, "X", "A", opts...); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+			t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+		}
+
+		if _, err = DijkstraPath(g, "A", "X", opts...); !errors.Is(err, grafik.ErrVertexDoesNotExist) {
+			t.Errorf("Expected %s, but got %v", grafik.ErrVertexDoesNotExist, err)
+		}
+	}
+}
+
+func TestDijkstraStopsAtTarget(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+
+	var simple, standard options.DijkstraProperties
+	standard.UseStandard()
+
+	for _, properties := range []options.DijkstraProperties{simple, standard} {
+		tree := dijkstra(g, "A", properties, func(label string) bool { return label == "B" })
+
+		if tree.Distance("B") != 1 {
+			t.Errorf("Expected distance from A to B to be 1, got %f", tree.Distance("B"))
+		}
+
+		if tree.HasPathTo("C") {
+			t.Errorf("Expected C not to be reached after stopping at B")
+		}
+	}
+}
+
+func TestDijkstraOfMultigraph(t *testing.T) {
+	g := grafik.New[string](options.WithMultigraph())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(7))
+	cheapest, _ := g.AddEdge(vA, vB, options.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(5))
+
+	for _, opts := range [][]options.DijkstraOptionFunc{nil, {options.WithDijkstraStandard()}} {
+		dist := Dijkstra(g, "A", opts...)
+		if dist["B"] != 2 || dist["C"] != 3 {
+			t.Errorf("Expected distances to B and C to be 2 and 3, got %f and %f", dist["B"], dist["C"])
+		}
+
+		path, err := DijkstraPath(g, "A", "C", opts...)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		if edges := path.Edges(); len(edges) != 2 || edges[0].ID() != cheapest.ID() {
+			t.Errorf("Expected the path to take the cheapest edge %d, got %v", cheapest.ID(), edges)
+		}
+	}
+}
+
+func TestDijkstraSelfLoop(t *testing.T) {
+	// a self-loop is either allowed or rejected, and never shortens a path.
+	for _, opts := range [][]options.GrafikOptionFunc{nil, {options.WithSelfLoopsRejected()}} {
+		g := grafik.New[string](opts...)
+
+		vA := g.AddVertexByLabel("A")
+		vB := g.AddVertexByLabel("B")
+		vC := g.AddVertexByLabel("C")
+
+		_, _ = g.AddEdge(vA, vA, options.WithEdgeWeight(0))
+		_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(2))
+		_, _ = g.AddEdge(vB, vB, options.WithEdgeWeight(1))
+		_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(3))
+
+		for _, dijkstraOpts := range [][]options.DijkstraOptionFunc{nil, {options.WithDijkstraStandard()}, {options.WithDijkstraBucketQueue()}} {
+			dist := Dijkstra(g, "A", dijkstraOpts...)
+			if dist["A"] != 0 || dist["B"] != 2 || dist["C"] != 5 {
+				t.Errorf("Expected distances 0, 2 and 5, got %v", dist)
+			}
+
+			path, err := DijkstraPath(g, "A", "C", dijkstraOpts...)
+			if err != nil || !reflect.DeepEqual(path.Labels(), []string{"A", "B", "C"}) {
+				t.Errorf("Expected path A B C, got %v, %v", path.Labels(), err)
+			}
+		}
+	}
+}
+
+func TestDijkstraBucketQueueLargeWeights(t *testing.T) {
+	g := grafik.New[string]()
+	a, b, c := g.AddVertexByLabel("A"), g.AddVertexByLabel("B"), g.AddVertexByLabel("C")
+
+	_, _ = g.AddEdge(a, b, options.WithEdgeWeight(1e20))
+	_, _ = g.AddEdge(a, c, options.WithEdgeWeight(1e10))
+	_, _ = g.AddEdge(c, b, options.WithEdgeWeight(5))
+
+	dist := Dijkstra(g, "A", options.WithDijkstraBucketQueue())
+	if dist["A"] != 0 || dist["B"] != 1e10+5 || dist["C"] != 1e10 {
+		t.Errorf("Expected distances 0, 1e10+5 and 1e10, got %v", dist)
+	}
+}
+
+func TestDijkstraQueues(t *testing.T) {
+	g, err := testgrafik.NewHub(200, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for i := 0; i < 200; i++ {
+		_, _ = g.AddEdge(g.GetVertexByLabel(i), g.GetVertexByLabel((i*7+3)%200), options.WithEdgeWeight(float64(i%5)))
+	}
+
+	expected := Dijkstra(g, 0)
+	for name, opt := range dijkstraQueues() {
+		if dist := Dijkstra(g, 0, opt); !reflect.DeepEqual(expected, dist) {
+			t.Errorf("Expected %s to find the same distances as the simple dijkstra", name)
+		}
+
+		path, err := DijkstraPath(g, 0, 150, opt)
+		if err != nil || path.Cost() != expected[150] {
+			t.Errorf("Expected %s path to 150 of weight %f, got %v, %v", name, expected[150], path.Cost(), err)
+		}
+	}
+}
+
+// dijkstraQueues returns the option that selects each priority queue.
+func dijkstraQueues() map[string]options.DijkstraOptionFunc {
+	return map[string]options.DijkstraOptionFunc{
+		"BinaryHeap":  options.WithDijkstraStandard(),
+		"PairingHeap": options.WithDijkstraPairingHeap(),
+		"DaryHeap":    options.WithDijkstraDaryHeap(4),
+		"BucketQueue": options.WithDijkstraBucketQueue(),
+	}
+}
+
+func BenchmarkSimpleDijkstra(b *testing.B) {
+	g, err := testgrafik.NewHub(2000, 10)
+	if err != nil {
+		b.Fatalf("Expected no error, but got %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Dijkstra(g, 0)
+	}
+}
+
+func BenchmarkStandardDijkstra(b *testing.B) {
+	g, err := testgrafik.NewHub(2000, 10)
+	if err != nil {
+		b.Fatalf("Expected no error, but got %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Dijkstra(g, 0, options.WithDijkstraStandard())
+	}
+}
+
+func BenchmarkPairingHeapDijkstra(b *testing.B) {
+	g, err := testgrafik.NewHub(2000, 10)
+	if err != nil {
+		b.Fatalf("Expected no error, but got %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Dijkstra(g, 0, options.WithDijkstraPairingHeap())
+	}
+}
+
+func BenchmarkDaryHeapDijkstra(b *testing.B) {
+	g, err := testgrafik.NewHub(2000, 10)
+	if err != nil {
+		b.Fatalf("Expected no error, but got %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Dijkstra(g, 0, options.WithDijkstraDaryHeap(4))
+	}
+}
+
+func BenchmarkBucketQueueDijkstra(b *testing.B) {
+	g, err := testgrafik.NewHub(2000, 10)
+	if err != nil {
+		b.Fatalf("Expected no error, but got %s", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Dijkstra(g, 0, options.WithDijkstraBucketQueue())
+	}
+}
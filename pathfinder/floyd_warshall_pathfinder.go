@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"math"
+
+	"github.com/fitm-elite/grafik"
+)
+
+// FloydWarshall finds the shortest paths between every pair of vertices
+// using the Floyd-Warshall algorithm. It supports negative edge weights,
+// and suits dense graphs better than running Dijkstra from every vertex.
+//
+// The time complexity of the Floyd-Warshall algorithm is O(V^3), and the
+// space complexity is O(V^2).
+//
+// If the graph has a negative cycle, returns *NegativeCycleError. Note
+// that in undirected graph any negative edge forms a negative cycle.
+func FloydWarshall[T comparable](g grafik.Grafik[T]) (*AllPairsShortestPaths[T], error) {
+	g = grafik.Snapshot(g)
+
+	a := newAllPairsShortestPaths(g)
+
+	for i, from := range a.labels {
+		v := g.GetVertexByLabel(from)
+		for _, neighbor := range v.Neighbors() {
+			j := a.index[neighbor.Label()]
+			if weight := g.GetEdge(v, neighbor).Weight(); weight < a.dist[i][j] {
+				a.dist[i][j] = weight
+				a.next[i][j] = j
+			}
+		}
+	}
+
+	n := len(a.labels)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if a.dist[i][k] == math.MaxFloat64 {
+				continue
+			}
+
+			for j := 0; j < n; j++ {
+				if a.dist[k][j] == math.MaxFloat64 {
+					continue
+				}
+
+				if alt := a.dist[i][k] + a.dist[k][j]; alt < a.dist[i][j] {
+					a.dist[i][j] = alt
+					a.next[i][j] = a.next[i][k]
+				}
+			}
+		}
+	}
+
+	// a vertex with a negative distance to itself is on a negative cycle,
+	// which Bellman-Ford from that vertex can report.
+	for i, label := range a.labels {
+		if a.dist[i][i] < 0 {
+			_, err := BellmanFord(g, label)
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
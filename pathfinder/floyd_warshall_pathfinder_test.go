@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestFloydWarshall(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(4))
+	_, _ = g.AddEdge(vA, vC, options.WithEdgeWeight(5))
+	_, _ = g.AddEdge(vC, vB, options.WithEdgeWeight(-3))
+	_, _ = g.AddEdge(vB, vD, options.WithEdgeWeight(2))
+	_, _ = g.AddEdge(vD, vA, options.WithEdgeWeight(1))
+
+	a, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for _, v := range g.GetAllVertices() {
+		tree, err := BellmanFord(g, v.Label())
+		if err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+
+		for label, dist := range tree.Distances() {
+			if a.Distance(v.Label(), label) != dist {
+				t.Errorf("Expected distance from %s to %s to be %f, got %f", v.Label(), label, dist, a.Distance(v.Label(), label))
+			}
+		}
+	}
+}
+
+func TestFloydWarshallNegativeCycle(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vD, vA, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(-3))
+	_, _ = g.AddEdge(vC, vA, options.WithEdgeWeight(1))
+
+	_, err := FloydWarshall(g)
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected *NegativeCycleError, but got %v", err)
+	}
+
+	if len(cycleErr.Cycle) != 3 {
+		t.Errorf("Expected a cycle of 3 vertices, got %v", cycleErr.Cycle)
+	}
+}
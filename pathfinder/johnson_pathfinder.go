@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"math"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+	"github.com/fitm-elite/grafik/queue"
+)
+
+// Johnson finds the shortest paths between every pair of vertices using
+// Johnson's algorithm. It reweights the edges with a Bellman-Ford pass so
+// that none of them is negative, and then runs Dijkstra from every vertex.
+// It supports negative edge weights, and suits sparse graphs better than
+// FloydWarshall. The options select the priority queue of the Dijkstra
+// runs, as they do for Dijkstra.
+//
+// The time complexity of Johnson's algorithm is O(V*E*log(V)).
+//
+// If the graph has a negative cycle, returns *NegativeCycleError. Note
+// that in undirected graph any negative edge forms a negative cycle.
+func Johnson[T comparable](g grafik.Grafik[T], opts ...options.DijkstraOptionFunc) (*AllPairsShortestPaths[T], error) {
+	g = grafik.Snapshot(g)
+
+	var properties options.DijkstraProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	a := newAllPairsShortestPaths(g)
+	if len(a.labels) == 0 {
+		return a, nil
+	}
+
+	potentials, err := johnsonPotentials(g, a.labels)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, label := range a.labels {
+		johnsonDijkstra(g, a, i, g.GetVertexByLabel(label), potentials, queue.NewVertexQueue[T](properties))
+	}
+
+	return a, nil
+}
+
+// johnsonPotentials returns the distances from a virtual vertex that has
+// an edge of weight 0 to every vertex. Instead of adding the vertex to the
+// graph, every distance starts at 0.
+func johnsonPotentials[T comparable](g grafik.Grafik[T], labels []T) (map[T]float64, error) {
+	tree := newShortestPathTree(g, labels[0])
+
+	edges := make([]*grafik.Edge[T], 0, len(labels))
+	for _, label := range labels {
+		tree.dist[label] = 0
+
+		v := g.GetVertexByLabel(label)
+		for _, neighbor := range v.Neighbors() {
+			edges = append(edges, g.GetEdge(v, neighbor))
+		}
+	}
+
+	// the virtual vertex makes V+1 vertices, so relax every edge V times.
+	for i := 0; i < len(labels); i++ {
+		updated := false
+		for _, edge := range edges {
+			if relax(tree, edge) {
+				updated = true
+			}
+		}
+
+		if !updated {
+			return tree.dist, nil
+		}
+	}
+
+	for _, edge := range edges {
+		if relax(tree, edge) {
+			return nil, &NegativeCycleError[T]{Cycle: findCycle(tree, edge.Destination().Label(), len(labels)+1)}
+		}
+	}
+
+	return tree.dist, nil
+}
+
+// johnsonDijkstra runs Dijkstra from the source vertex with the edge
+// weights reweighted by the potentials, and fills in the row of the source
+// vertex in the distance and the next-hop matrices. The input priority
+// queue must be empty.
+func johnsonDijkstra[T comparable](g grafik.Grafik[T], a *AllPairsShortestPaths[T], i int, source *grafik.Vertex[T], potentials map[T]float64, pq queue.VertexQueue[T]) {
+	dist := map[T]float64{source.Label(): 0}
+
+	// firstHop keeps the vertex after the source on the path to each vertex.
+	firstHop := make(map[T]T)
+
+	pq.Push(queue.NewVertexWithPriority(source, 0))
+
+	for pq.Len() > 0 {
+		u := pq.Pop().Vertex()
+
+		j := a.index[u.Label()]
+		a.dist[i][j] = dist[u.Label()] - potentials[source.Label()] + potentials[u.Label()]
+		if u.Label() != source.Label() {
+			a.next[i][j] = a.index[firstHop[u.Label()]]
+		}
+
+		for _, v := range u.Neighbors() {
+			weight := g.GetEdge(u, v).Weight() + potentials[u.Label()] - potentials[v.Label()]
+
+			// the reweighted edges are never negative, but rounding may make them slightly so.
+			alt := dist[u.Label()] + math.Max(weight, 0)
+			if d, ok := dist[v.Label()]; ok && alt >= d {
+				continue
+			}
+
+			dist[v.Label()] = alt
+			if u.Label() == source.Label() {
+				firstHop[v.Label()] = v.Label()
+			} else {
+				firstHop[v.Label()] = firstHop[u.Label()]
+			}
+
+			if !pq.DecreaseKey(v.Label(), alt) {
+				pq.Push(queue.NewVertexWithPriority(v, alt))
+			}
+		}
+	}
+}
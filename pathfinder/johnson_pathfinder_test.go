@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestJohnsonMatchesFloydWarshall(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	// edges only go from lower to higher labels, so negative weights can't form a cycle.
+	g := grafik.New[int](options.WithDirected())
+	for i := 0; i < 40; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 120; i++ {
+		from, to := rnd.Intn(40), rnd.Intn(40)
+		if from > to {
+			from, to = to, from
+		}
+
+		if from != to {
+			_, _ = g.AddEdge(g.GetVertexByLabel(from), g.GetVertexByLabel(to), options.WithEdgeWeight(float64(rnd.Intn(20)-5)))
+		}
+	}
+
+	expected, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	a, err := Johnson(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for from := 0; from < 40; from++ {
+		for to := 0; to < 40; to++ {
+			if a.Distance(from, to) != expected.Distance(from, to) {
+				t.Errorf("Expected distance from %d to %d to be %f, got %f", from, to, expected.Distance(from, to), a.Distance(from, to))
+			}
+
+			path, err := a.Path(from, to)
+			if errors.Is(err, ErrNoPath) {
+				continue
+			}
+
+			cost := 0.0
+			for _, edge := range path.Edges() {
+				cost += edge.Weight()
+			}
+
+			if cost != a.Distance(from, to) || path.Labels()[0] != from || path.Labels()[len(path.Labels())-1] != to {
+				t.Errorf("Expected path from %d to %d with cost %f, got %v with cost %f", from, to, a.Distance(from, to), path.Labels(), cost)
+			}
+		}
+	}
+}
+
+func TestJohnsonQueues(t *testing.T) {
+	// the edges only go to higher labels, so the negative weights can't form a cycle.
+	g := grafik.New[int](options.WithDirected())
+	for i := 0; i < 50; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < 49; i++ {
+		_, _ = g.AddEdge(g.GetVertexByLabel(i), g.GetVertexByLabel(i+1), options.WithEdgeWeight(2))
+		if j := (i*7 + 3) % 50; j > i+1 {
+			_, _ = g.AddEdge(g.GetVertexByLabel(i), g.GetVertexByLabel(j), options.WithEdgeWeight(float64(i%5-2)))
+		}
+	}
+
+	expected, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	for name, opt := range dijkstraQueues() {
+		a, err := Johnson(g, opt)
+		if err != nil {
+			t.Fatalf("Expected no error with %s, but got %s", name, err)
+		}
+
+		for from := 0; from < 50; from++ {
+			for to := 0; to < 50; to++ {
+				if a.Distance(from, to) != expected.Distance(from, to) {
+					t.Errorf("Expected %s distance from %d to %d to be %f, got %f", name, from, to, expected.Distance(from, to), a.Distance(from, to))
+				}
+			}
+		}
+	}
+}
+
+func TestJohnsonNegativeCycle(t *testing.T) {
+	g := grafik.New[string](options.WithDirected())
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+
+	_, _ = g.AddEdge(vD, vA, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vA, vB, options.WithEdgeWeight(1))
+	_, _ = g.AddEdge(vB, vC, options.WithEdgeWeight(-3))
+	_, _ = g.AddEdge(vC, vA, options.WithEdgeWeight(1))
+
+	_, err := Johnson(g)
+
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected *NegativeCycleError, but got %v", err)
+	}
+
+	// the cycle must follow the edge direction.
+	for i, label := range cycleErr.Cycle {
+		from := g.GetVertexByLabel(label)
+		to := g.GetVertexByLabel(cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)])
+		if !g.ContainsEdge(from, to) {
+			t.Errorf("Expected edge %v -> %v in cycle %v", label, cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)], cycleErr.Cycle)
+		}
+	}
+
+	if len(cycleErr.Cycle) != 3 {
+		t.Errorf("Expected a cycle of 3 vertices, got %v", cycleErr.Cycle)
+	}
+
+	if a, err := Johnson(grafik.New[string]()); err != nil || len(a.Labels()) != 0 {
+		t.Errorf("Expected empty result for empty graph, but got %v", err)
+	}
+}
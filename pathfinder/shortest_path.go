@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/fitm-elite/grafik"
+)
+
+var ErrNoPath = errors.New("no path between vertices")
+
+// Path represents a path between two vertices in a graph. It contains
+// the ordered vertex labels, the edges used along the way and the total cost.
+//
+// On a concurrent graph, the edges belong to the grafik.Snapshot that the
+// path was found on, so compare them with the edges of the graph by id.
+type Path[T comparable] struct {
+	labels []T
+	edges  []*grafik.Edge[T]
+	cost   float64
+}
+
+// Labels returns the ordered vertex labels from the start to the end of the path.
+func (p Path[T]) Labels() []T {
+	return p.labels
+}
+
+// Edges returns the ordered edges from the start to the end of the path.
+func (p Path[T]) Edges() []*grafik.Edge[T] {
+	return p.edges
+}
+
+// Cost returns the sum of the edge weights along the path.
+func (p Path[T]) Cost() float64 {
+	return p.cost
+}
+
+// ShortestPathTree represents the shortest paths from a single source
+// vertex to all other vertices in a graph. For each reached vertex, it
+// keeps the distance from the source and the previous vertex on the path.
+type ShortestPathTree[T comparable] struct {
+	graph    grafik.Grafik[T]
+	source   T
+	dist     map[T]float64
+	previous map[T]T
+}
+
+func newShortestPathTree[T comparable](g grafik.Grafik[T], source T) *ShortestPathTree[T] {
+	return &ShortestPathTree[T]{
+		graph:    g,
+		source:   source,
+		dist:     make(map[T]float64),
+		previous: make(map[T]T),
+	}
+}
+
+// Source returns the label of the source vertex.
+func (s *ShortestPathTree[T]) Source() T {
+	return s.source
+}
+
+// Distances returns a copy of the distances from the source vertex to all
+// other vertices. Unreachable vertices have a distance of math.MaxFloat64.
+func (s *ShortestPathTree[T]) Distances() map[T]float64 {
+	return maps.Clone(s.dist)
+}
+
+// Distance returns the distance from the source vertex to the vertex with
+// the input label.
+//
+// If the vertex is unreachable or doesn't exist, returns math.MaxFloat64.
+func (s *ShortestPathTree[T]) Distance(label T) float64 {
+	if dist, ok := s.dist[label]; ok {
+		return dist
+	}
+
+	return math.MaxFloat64
+}
+
+// HasPathTo returns 'true' if the vertex with the input label can be
+// reached from the source vertex.
+func (s *ShortestPathTree[T]) HasPathTo(label T) bool {
+	return s.Distance(label) != math.MaxFloat64
+}
+
+// Previous returns the label of the vertex before the input vertex on
+// the shortest path from the source vertex.
+//
+// If the input vertex is the source or is unreachable, returns 'false'.
+func (s *ShortestPathTree[T]) Previous(label T) (T, bool) {
+	previous, ok := s.previous[label]
+	return previous, ok
+}
+
+// Predecessors returns a copy of the predecessor of each reached vertex,
+// except the source vertex.
+func (s *ShortestPathTree[T]) Predecessors() map[T]T {
+	return maps.Clone(s.previous)
+}
+
+// PathTo returns the shortest path from the source vertex to the vertex
+// with the input label.
+//
+// If the vertex doesn't exist, returns grafik.ErrVertexDoesNotExist.
+// If the vertex is unreachable, returns ErrNoPath.
+func (s *ShortestPathTree[T]) PathTo(label T) (Path[T], error) {
+	if s.graph.GetVertexByLabel(label) == nil {
+		return Path[T]{}, grafik.ErrVertexDoesNotExist
+	}
+
+	if !s.HasPathTo(label) {
+		return Path[T]{}, ErrNoPath
+	}
+
+	labels := []T{label}
+	for curr := label; curr != s.source; {
+		curr = s.previous[curr]
+		labels = append(labels, curr)
+	}
+
+	// labels are collected from the end of the path, so reverse them.
+	slices.Reverse(labels)
+
+	edges := make([]*grafik.Edge[T], 0, len(labels)-1)
+	for i := 1; i < len(labels); i++ {
+		from := s.graph.GetVertexByLabel(labels[i-1])
+		to := s.graph.GetVertexByLabel(labels[i])
+		edges = append(edges, s.graph.GetEdge(from, to))
+	}
+
+	return Path[T]{labels: labels, edges: edges, cost: s.dist[label]}, nil
+}
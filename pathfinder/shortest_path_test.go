@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pathfinder
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestShortestPathTree(t *testing.T) {
+	g := grafik.New[int]()
+
+	v1 := g.AddVertexByLabel(1)
+	v2 := g.AddVertexByLabel(2)
+	v3 := g.AddVertexByLabel(3)
+	_ = g.AddVertexByLabel(4)
+
+	_, _ = g.AddEdge(v1, v2, options.WithEdgeWeight(2))
+	_, _ = g.AddEdge(v2, v3, options.WithEdgeWeight(3))
+
+	tree, err := DijkstraTree(g, 1, options.WithDijkstraStandard())
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	if tree.Source() != 1 {
+		t.Errorf("Expected source to be 1, got %d", tree.Source())
+	}
+
+	if tree.Distance(3) != 5 {
+		t.Errorf("Expected distance to 3 to be 5, got %f", tree.Distance(3))
+	}
+
+	if tree.HasPathTo(4) || tree.Distance(4) != math.MaxFloat64 {
+		t.Errorf("Expected 4 to be unreachable, got %f", tree.Distance(4))
+	}
+
+	if previous, ok := tree.Previous(3); !ok || previous != 2 {
+		t.Errorf("Expected previous of 3 to be 2, got %d", previous)
+	}
+
+	if _, ok := tree.Previous(1); ok {
+		t.Error("Expected source to have no previous vertex")
+	}
+
+	// changing the returned distances doesn't change the tree.
+	distances := tree.Distances()
+	distances[3] = 0
+	if tree.Distance(3) != 5 {
+		t.Errorf("Expected distance to 3 to be 5, got %f", tree.Distance(3))
+	}
+
+	path, err := tree.PathTo(1)
+	if err != nil {
+		t.Errorf("Expected no error, but got %s", err)
+	}
+
+	if !reflect.DeepEqual(path.Labels(), []int{1}) || len(path.Edges()) != 0 || path.Cost() != 0 {
+		t.Errorf("Expected an empty path to the source, got %+v", path)
+	}
+
+	if _, err = DijkstraTree(g, 5); err == nil {
+		t.Error("Expected error, but got no error")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import "math"
+
+// BucketQueue is a min priority queue of vertices that keeps a bucket for
+// each integer priority, as in Dial's algorithm. Push and DecreaseKey take
+// constant time, and Pop scans the buckets from the lowest non-empty one,
+// so it suits graphs with small non-negative integer weights.
+//
+// The item is kept in the bucket of its priority rounded down, so the queue
+// also works with fractional priorities, though the items that share a
+// bucket are then searched linearly. Negative priorities are kept in the
+// first bucket, and the priorities from maxBuckets-1 up, including the
+// infinite ones, share the last bucket, so large weights don't make the
+// queue allocate a bucket for each integer below them.
+type BucketQueue[T comparable] struct {
+	buckets [][]*bucketItem[T]
+	items   map[T]*bucketItem[T] // the queued items by vertex label.
+	lowest  int                  // no bucket below it has any item.
+}
+
+// bucketItem represents an item of a bucket queue, with its index in its bucket.
+type bucketItem[T comparable] struct {
+	value *VertexWithPriority[T]
+	index int
+}
+
+func NewBucketQueue[T comparable]() *BucketQueue[T] {
+	return &BucketQueue[T]{items: make(map[T]*bucketItem[T])}
+}
+
+// Push adds new VertexWithPriority to the queue. If a vertex with the
+// same label is already in the queue, Push lowers its priority as
+// DecreaseKey does, and ignores a priority that isn't lower, so the queued
+// priority is never raised.
+func (b *BucketQueue[T]) Push(in *VertexWithPriority[T]) {
+	if b.Contains(in.vertex.Label()) {
+		b.DecreaseKey(in.vertex.Label(), in.priority)
+		return
+	}
+
+	item := &bucketItem[T]{value: in}
+	b.items[in.vertex.Label()] = item
+	b.insert(item)
+}
+
+// Pop removes and returns the item with the minimum priority.
+// If the queue is empty, returns nil.
+func (b *BucketQueue[T]) Pop() *VertexWithPriority[T] {
+	out := b.peek()
+	if out == nil {
+		return nil
+	}
+
+	b.remove(out)
+	delete(b.items, out.value.vertex.Label())
+
+	return out.value
+}
+
+// Peek returns the item with the minimum priority without removing it.
+// If the queue is empty, returns nil.
+func (b *BucketQueue[T]) Peek() *VertexWithPriority[T] {
+	out := b.peek()
+	if out == nil {
+		return nil
+	}
+
+	return out.value
+}
+
+// peek returns the queued item with the minimum priority, or nil if the
+// queue is empty.
+func (b *BucketQueue[T]) peek() *bucketItem[T] {
+	if len(b.items) == 0 {
+		return nil
+	}
+
+	for len(b.buckets[b.lowest]) == 0 {
+		b.lowest++
+	}
+
+	bucket := b.buckets[b.lowest]
+	out := bucket[0]
+	for _, item := range bucket[1:] {
+		if item.value.priority < out.value.priority {
+			out = item
+		}
+	}
+
+	return out
+}
+
+// Len returns the number of items in the queue.
+func (b *BucketQueue[T]) Len() int {
+	return len(b.items)
+}
+
+// Contains returns 'true' if the vertex with the input label is in the queue.
+func (b *BucketQueue[T]) Contains(label T) bool {
+	_, ok := b.items[label]
+	return ok
+}
+
+// DecreaseKey lowers the priority of the vertex with the input label, and
+// moves it to the bucket of the new priority.
+//
+// It returns 'false' if the vertex isn't in the queue, or if the input
+// priority isn't lower than the current one.
+func (b *BucketQueue[T]) DecreaseKey(label T, priority float64) bool {
+	item, ok := b.items[label]
+	if !ok || priority >= item.value.priority {
+		return false
+	}
+
+	b.remove(item)
+	item.value.priority = priority
+	b.insert(item)
+
+	return true
+}
+
+// insert appends the item to the bucket of its priority.
+func (b *BucketQueue[T]) insert(item *bucketItem[T]) {
+	i := bucketOf(item.value.priority)
+	for len(b.buckets) <= i {
+		b.buckets = append(b.buckets, nil)
+	}
+
+	item.index = len(b.buckets[i])
+	b.buckets[i] = append(b.buckets[i], item)
+
+	if i < b.lowest {
+		b.lowest = i
+	}
+}
+
+// remove removes the item from its bucket by moving the last item of the
+// bucket to its place.
+func (b *BucketQueue[T]) remove(item *bucketItem[T]) {
+	i := bucketOf(item.value.priority)
+	bucket := b.buckets[i]
+
+	last := len(bucket) - 1
+	bucket[item.index] = bucket[last]
+	bucket[item.index].index = item.index
+	bucket[last] = nil // avoid memory leak
+	b.buckets[i] = bucket[:last]
+
+	item.index = -1
+}
+
+// maxBuckets is the highest number of buckets of BucketQueue.
+const maxBuckets = 1 << 16
+
+// bucketOf returns the index of the bucket of the priority.
+func bucketOf(priority float64) int {
+	switch {
+	case priority <= 0 || math.IsNaN(priority):
+		return 0
+	case priority >= maxBuckets-1:
+		// the priority is compared before the conversion, which overflows for large ones.
+		return maxBuckets - 1
+	}
+
+	return int(math.Floor(priority))
+}
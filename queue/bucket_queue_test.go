@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+)
+
+func TestBucketQueue(t *testing.T) {
+	bq := NewBucketQueue[string]()
+
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("A"), 4))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("B"), 2))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("C"), 2.5))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("D"), 2.25))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("E"), 9))
+
+	if item := bq.Pop(); item.Vertex().Label() != "B" {
+		t.Errorf("Expected Pop returns B, but got %v", item.Vertex().Label())
+	}
+
+	// fractional priorities in the same bucket are still ordered.
+	if item := bq.Peek(); item.Vertex().Label() != "D" {
+		t.Errorf("Expected Peek returns D, but got %v", item.Vertex().Label())
+	}
+
+	// a vertex can move below the lowest bucket that has been popped.
+	if !bq.DecreaseKey("E", 1) || bq.Peek().Vertex().Label() != "E" {
+		t.Errorf("Expected DecreaseKey to move E to the top, but got %v", bq.Peek().Vertex().Label())
+	}
+
+	// negative priorities are kept in the first bucket.
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("F"), -1))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("A"), 0.5))
+
+	items := make([]string, 0)
+	for bq.Len() > 0 {
+		items = append(items, bq.Pop().Vertex().Label())
+	}
+
+	expected := []string{"F", "A", "E", "D", "C"}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("BucketQueue Pop() order = %v; want %v", items, expected)
+	}
+}
+
+func TestBucketQueueLargePriorities(t *testing.T) {
+	bq := NewBucketQueue[string]()
+
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("A"), 1e20))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("B"), math.Inf(1)))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("C"), 1e10))
+	bq.Push(NewVertexWithPriority(grafik.NewVertex("D"), 3))
+
+	// the large priorities share the last bucket instead of one bucket each.
+	if len(bq.buckets) > maxBuckets {
+		t.Errorf("Expected at most %d buckets, but got %d", maxBuckets, len(bq.buckets))
+	}
+
+	if !bq.DecreaseKey("B", 1e15) {
+		t.Errorf("Expected DecreaseKey to lower B")
+	}
+
+	items := make([]string, 0)
+	for bq.Len() > 0 {
+		items = append(items, bq.Pop().Vertex().Label())
+	}
+
+	expected := []string{"D", "C", "B", "A"}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("BucketQueue Pop() order = %v; want %v", items, expected)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+// DaryHeap is a min priority queue of vertices implemented as a d-ary heap,
+// where each node has up to d children. A higher arity makes the heap
+// shallower, so DecreaseKey is cheaper and Pop compares more children,
+// which suits dense graphs with many decrease-key operations.
+type DaryHeap[T comparable] struct {
+	d     int
+	heap  []*daryItem[T]
+	items map[T]*daryItem[T] // the queued items by vertex label.
+}
+
+// daryItem represents an item of a d-ary heap, with its index in the heap.
+type daryItem[T comparable] struct {
+	value *VertexWithPriority[T]
+	index int
+}
+
+// NewDaryHeap returns an empty d-ary heap. If d is lower than 2, the heap
+// is a binary heap.
+func NewDaryHeap[T comparable](d int) *DaryHeap[T] {
+	if d < 2 {
+		d = 2
+	}
+
+	return &DaryHeap[T]{
+		d:     d,
+		items: make(map[T]*daryItem[T]),
+	}
+}
+
+// Push adds new VertexWithPriority to the queue. If a vertex with the
+// same label is already in the queue, Push lowers its priority as
+// DecreaseKey does, and ignores a priority that isn't lower, so the queued
+// priority is never raised.
+func (h *DaryHeap[T]) Push(in *VertexWithPriority[T]) {
+	if h.Contains(in.vertex.Label()) {
+		h.DecreaseKey(in.vertex.Label(), in.priority)
+		return
+	}
+
+	item := &daryItem[T]{value: in, index: len(h.heap)}
+	h.heap = append(h.heap, item)
+	h.items[in.vertex.Label()] = item
+	h.up(item.index)
+}
+
+// Pop removes and returns the item with the minimum priority.
+// If the queue is empty, returns nil.
+func (h *DaryHeap[T]) Pop() *VertexWithPriority[T] {
+	if len(h.heap) == 0 {
+		return nil
+	}
+
+	out := h.heap[0]
+	last := len(h.heap) - 1
+	h.swap(0, last)
+	h.heap[last] = nil // avoid memory leak
+	h.heap = h.heap[:last]
+	h.down(0)
+
+	delete(h.items, out.value.vertex.Label())
+
+	return out.value
+}
+
+// Peek returns the item with the minimum priority without removing it.
+// If the queue is empty, returns nil.
+func (h *DaryHeap[T]) Peek() *VertexWithPriority[T] {
+	if len(h.heap) == 0 {
+		return nil
+	}
+
+	return h.heap[0].value
+}
+
+// Len returns the number of items in the queue.
+func (h *DaryHeap[T]) Len() int {
+	return len(h.heap)
+}
+
+// Contains returns 'true' if the vertex with the input label is in the queue.
+func (h *DaryHeap[T]) Contains(label T) bool {
+	_, ok := h.items[label]
+	return ok
+}
+
+// DecreaseKey lowers the priority of the vertex with the input label.
+//
+// It returns 'false' if the vertex isn't in the queue, or if the input
+// priority isn't lower than the current one.
+func (h *DaryHeap[T]) DecreaseKey(label T, priority float64) bool {
+	item, ok := h.items[label]
+	if !ok || priority >= item.value.priority {
+		return false
+	}
+
+	item.value.priority = priority
+	h.up(item.index)
+
+	return true
+}
+
+// up moves the item at index i towards the root while it has a lower
+// priority than its parent.
+func (h *DaryHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.d
+		if h.heap[i].value.priority >= h.heap[parent].value.priority {
+			break
+		}
+
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at index i towards the leaves while one of its
+// children has a lower priority.
+func (h *DaryHeap[T]) down(i int) {
+	for {
+		first := i*h.d + 1
+		if first >= len(h.heap) {
+			return
+		}
+
+		smallest := first
+		for c := first + 1; c < first+h.d && c < len(h.heap); c++ {
+			if h.heap[c].value.priority < h.heap[smallest].value.priority {
+				smallest = c
+			}
+		}
+
+		if h.heap[smallest].value.priority >= h.heap[i].value.priority {
+			return
+		}
+
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// swap swaps the items with indexes i and j.
+func (h *DaryHeap[T]) swap(i, j int) {
+	h.heap[i], h.heap[j] = h.heap[j], h.heap[i]
+	h.heap[i].index = i
+	h.heap[j].index = j
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+)
+
+func TestDaryHeap(t *testing.T) {
+	if dh := NewDaryHeap[string](1); dh.d != 2 {
+		t.Errorf("Expected arity lower than 2 to be 2, but got %d", dh.d)
+	}
+
+	for _, d := range []int{2, 3, 8} {
+		dh := NewDaryHeap[string](d)
+
+		dh.Push(NewVertexWithPriority(grafik.NewVertex("A"), 5))
+		dh.Push(NewVertexWithPriority(grafik.NewVertex("B"), 3))
+		dh.Push(NewVertexWithPriority(grafik.NewVertex("C"), 8))
+		dh.Push(NewVertexWithPriority(grafik.NewVertex("D"), 1))
+		dh.Push(NewVertexWithPriority(grafik.NewVertex("E"), 6))
+
+		if !dh.DecreaseKey("C", 2) || dh.DecreaseKey("C", 9) {
+			t.Errorf("Expected DecreaseKey to lower C only once for arity %d", d)
+		}
+
+		items := make([]string, 0)
+		for dh.Len() > 0 {
+			items = append(items, dh.Pop().Vertex().Label())
+		}
+
+		expected := []string{"D", "C", "B", "A", "E"}
+		if !reflect.DeepEqual(items, expected) {
+			t.Errorf("DaryHeap(%d) Pop() order = %v; want %v", d, items, expected)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+// pairingNode represents a node of a pairing heap. The children of a node
+// are a doubly linked list, and the first child points back to its parent
+// through prev.
+type pairingNode[T comparable] struct {
+	item    *VertexWithPriority[T]
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+	prev    *pairingNode[T]
+}
+
+// PairingHeap is a min priority queue of vertices implemented as a pairing
+// heap. Push and DecreaseKey take constant time, and Pop takes amortized
+// O(log n) time, which suits algorithms with many decrease-key operations.
+type PairingHeap[T comparable] struct {
+	root  *pairingNode[T]
+	nodes map[T]*pairingNode[T]
+}
+
+func NewPairingHeap[T comparable]() *PairingHeap[T] {
+	return &PairingHeap[T]{nodes: make(map[T]*pairingNode[T])}
+}
+
+// Push adds new VertexWithPriority to the queue. If a vertex with the
+// same label is already in the queue, Push lowers its priority as
+// DecreaseKey does, and ignores a priority that isn't lower, so the queued
+// priority is never raised.
+func (p *PairingHeap[T]) Push(in *VertexWithPriority[T]) {
+	if p.Contains(in.vertex.Label()) {
+		p.DecreaseKey(in.vertex.Label(), in.priority)
+		return
+	}
+
+	node := &pairingNode[T]{item: in}
+	p.nodes[in.vertex.Label()] = node
+	p.root = meld(p.root, node)
+}
+
+// Pop removes and returns the item with the minimum priority.
+// If the queue is empty, returns nil.
+func (p *PairingHeap[T]) Pop() *VertexWithPriority[T] {
+	if p.root == nil {
+		return nil
+	}
+
+	out := p.root
+	delete(p.nodes, out.item.vertex.Label())
+	p.root = mergePairs(out.child)
+
+	return out.item
+}
+
+// Peek returns the item with the minimum priority without removing it.
+// If the queue is empty, returns nil.
+func (p *PairingHeap[T]) Peek() *VertexWithPriority[T] {
+	if p.root == nil {
+		return nil
+	}
+
+	return p.root.item
+}
+
+// Len returns the number of items in the queue.
+func (p *PairingHeap[T]) Len() int {
+	return len(p.nodes)
+}
+
+// Contains returns 'true' if the vertex with the input label is in the queue.
+func (p *PairingHeap[T]) Contains(label T) bool {
+	_, ok := p.nodes[label]
+	return ok
+}
+
+// DecreaseKey lowers the priority of the vertex with the input label. The
+// subtree of the vertex is cut off and melded with the root again.
+//
+// It returns 'false' if the vertex isn't in the queue, or if the input
+// priority isn't lower than the current one.
+func (p *PairingHeap[T]) DecreaseKey(label T, priority float64) bool {
+	node, ok := p.nodes[label]
+	if !ok || priority >= node.item.priority {
+		return false
+	}
+
+	node.item.priority = priority
+	if node != p.root {
+		p.cut(node)
+		p.root = meld(p.root, node)
+	}
+
+	return true
+}
+
+// cut detaches the node with its subtree from its parent. If the node is
+// the root, the root is replaced by the merged children of the node.
+func (p *PairingHeap[T]) cut(node *pairingNode[T]) {
+	if node == p.root {
+		p.root = mergePairs(node.child)
+		node.child = nil
+
+		return
+	}
+
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+
+	node.prev, node.sibling = nil, nil
+}
+
+// meld links two heaps, making the root with the greater priority the
+// first child of the other one.
+func meld[T comparable](a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if b.item.priority < a.item.priority {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+
+	a.child = b
+
+	return a
+}
+
+// mergePairs melds the list of sibling heaps into one heap, by melding them
+// in pairs from the left and then melding the pairs from the right.
+func mergePairs[T comparable](first *pairingNode[T]) *pairingNode[T] {
+	pairs := make([]*pairingNode[T], 0)
+	for first != nil {
+		a, b := first, first.sibling
+		first = nil
+		if b != nil {
+			first = b.sibling
+			b.prev, b.sibling = nil, nil
+		}
+
+		a.prev, a.sibling = nil, nil
+		pairs = append(pairs, meld(a, b))
+	}
+
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = meld(pairs[i], root)
+	}
+
+	return root
+}
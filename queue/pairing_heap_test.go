@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+)
+
+func TestPairingHeap(t *testing.T) {
+	ph := NewPairingHeap[string]()
+
+	for i, label := range []string{"A", "B", "C", "D", "E", "F"} {
+		ph.Push(NewVertexWithPriority(grafik.NewVertex(label), float64(10+i)))
+	}
+
+	// pop once, so the remaining vertices are children of each other.
+	if item := ph.Pop(); item.Vertex().Label() != "A" {
+		t.Errorf("Expected Pop returns A, but got %v", item.Vertex().Label())
+	}
+
+	// decrease a vertex below the root, and the root itself.
+	if !ph.DecreaseKey("E", 1) || ph.Peek().Vertex().Label() != "E" {
+		t.Errorf("Expected DecreaseKey to move E to the top, but got %v", ph.Peek().Vertex().Label())
+	}
+
+	if !ph.DecreaseKey("E", 0) || ph.Peek().Priority() != 0 {
+		t.Errorf("Expected DecreaseKey to lower the root to 0, but got %v", ph.Peek().Priority())
+	}
+
+	if !ph.DecreaseKey("F", 2) || ph.Peek().Vertex().Label() != "E" {
+		t.Errorf("Expected DecreaseKey to keep E on top, but got %v", ph.Peek().Vertex().Label())
+	}
+
+	items := make([]string, 0)
+	for ph.Len() > 0 {
+		items = append(items, ph.Pop().Vertex().Label())
+	}
+
+	expected := []string{"E", "F", "B", "C", "D"}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("PairingHeap Pop() order = %v; want %v", items, expected)
+	}
+
+	if ph.Contains("E") {
+		t.Errorf("Expected popped vertex not to be contained")
+	}
+}
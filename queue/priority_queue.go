@@ -23,112 +23,188 @@ package queue
 import (
 	"container/heap"
 
-	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
 )
 
-// VertexPriorityQueue wraps the priorityQueue type to decrease the
-// exposes methods, and increase the type safety.
-type VertexPriorityQueue[T comparable] struct {
-	pq priorityQueue[T] // a slice of VertexWithPriority that represents min heap.
+// Item is an element of a PriorityQueue. It is returned by Push, so the
+// element can be updated or removed later.
+type Item[E any] struct {
+	value E
+	seq   uint64 // the insertion order, to break ties.
+	index int    // the index in the heap, -1 once the item is removed.
 }
 
-func NewVertexPriorityQueue[T comparable]() *VertexPriorityQueue[T] {
-	pq := make(priorityQueue[T], 0)
-	heap.Init(&pq)
-	return &VertexPriorityQueue[T]{
-		pq: pq,
+// Value returns the element of the item.
+func (i *Item[E]) Value() E {
+	return i.value
+}
+
+// PriorityQueue is a generic priority queue ordered by a comparator. By
+// default it pops the least element first, and options.WithPriorityQueueMax
+// makes it pop the greatest one. The elements that compare as equal are
+// popped in the order they have been pushed.
+type PriorityQueue[E any] struct {
+	pq  priorityQueue[E]
+	seq uint64 // the sequence number of the next pushed item.
+}
+
+// NewPriorityQueue returns an empty priority queue ordered by the compare
+// function, which returns a negative number when a is less than b, a
+// positive number when a is greater than b, and zero otherwise, like
+// cmp.Compare.
+func NewPriorityQueue[E any](compare func(a, b E) int, opts ...options.PriorityQueueOptionFunc) *PriorityQueue[E] {
+	var properties options.PriorityQueueProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	return &PriorityQueue[E]{
+		pq: priorityQueue[E]{
+			compare: compare,
+			max:     properties.IsMax(),
+		},
 	}
 }
 
-// Push adds new VertexWithPriority to the queue.
-func (v *VertexPriorityQueue[T]) Push(in *VertexWithPriority[T]) {
-	heap.Push(&v.pq, in)
+// Push adds the element to the queue, and returns its item.
+func (p *PriorityQueue[E]) Push(value E) *Item[E] {
+	item := &Item[E]{value: value, seq: p.seq}
+	p.seq++
+
+	heap.Push(&p.pq, item)
+
+	return item
 }
 
-// Pop removes and returns the minimum element (according to Less) from
-// the underlying heap.
-func (v *VertexPriorityQueue[T]) Pop() *VertexWithPriority[T] {
-	out, _ := heap.Pop(&v.pq).(*VertexWithPriority[T])
-	return out
+// Pop removes and returns the first element of the queue.
+// It returns 'false' if the queue is empty.
+func (p *PriorityQueue[E]) Pop() (E, bool) {
+	if p.Len() == 0 {
+		var zero E
+		return zero, false
+	}
+
+	item, _ := heap.Pop(&p.pq).(*Item[E])
+	return item.value, true
 }
 
-// Peek is the number of elements in the underlying queue.
-func (v *VertexPriorityQueue[T]) Peek() *VertexWithPriority[T] {
-	if v.Len() > 0 {
-		return v.pq[0]
+// Peek returns the first element of the queue without removing it.
+// It returns 'false' if the queue is empty.
+func (p *PriorityQueue[E]) Peek() (E, bool) {
+	if p.Len() == 0 {
+		var zero E
+		return zero, false
 	}
 
-	return nil
+	return p.pq.items[0].value, true
 }
 
-// Len is the number of elements in the underlying queue.
-func (v *VertexPriorityQueue[T]) Len() int {
-	return len(v.pq)
+// Len returns the number of elements in the queue.
+func (p *PriorityQueue[E]) Len() int {
+	return p.pq.Len()
 }
 
-// VertexWithPriority is a vertex priority queue item that stores
-// vertex along with its priority.
-type VertexWithPriority[T comparable] struct {
-	vertex   *grafik.Vertex[T]
-	priority float64
-	index    int
+// Contains returns 'true' if the item is in the queue.
+func (p *PriorityQueue[E]) Contains(item *Item[E]) bool {
+	return item != nil && item.index >= 0 && item.index < p.pq.Len() && p.pq.items[item.index] == item
 }
 
-func NewVertexWithPriority[T comparable](vertex *grafik.Vertex[T], priority float64) *VertexWithPriority[T] {
-	return &VertexWithPriority[T]{vertex: vertex, priority: priority}
+// Update replaces the element of the item, and moves the item to its new
+// position. The item keeps its insertion order among the equal elements.
+//
+// It returns 'false' if the item isn't in the queue.
+func (p *PriorityQueue[E]) Update(item *Item[E], value E) bool {
+	if !p.Contains(item) {
+		return false
+	}
+
+	item.value = value
+	heap.Fix(&p.pq, item.index)
+
+	return true
 }
 
-// Priority returns the priority of the vertex.
-func (v VertexWithPriority[T]) Priority() float64 {
-	return v.priority
+// Fix moves the item to its new position after its element has been
+// changed in place, such as through a pointer.
+//
+// It returns 'false' if the item isn't in the queue.
+func (p *PriorityQueue[E]) Fix(item *Item[E]) bool {
+	if !p.Contains(item) {
+		return false
+	}
+
+	heap.Fix(&p.pq, item.index)
+
+	return true
 }
 
-// Vertex returns the vertex.
-func (v VertexWithPriority[T]) Vertex() *grafik.Vertex[T] {
-	return v.vertex
+// Remove removes the item from the queue, and returns its element.
+//
+// It returns 'false' if the item isn't in the queue.
+func (p *PriorityQueue[E]) Remove(item *Item[E]) (E, bool) {
+	if !p.Contains(item) {
+		var zero E
+		return zero, false
+	}
+
+	heap.Remove(&p.pq, item.index)
+
+	return item.value, true
 }
 
-// priorityQueue is a priority queue that implements
-// heap and sort interfaces. It represents a min heap.
-type priorityQueue[T comparable] []*VertexWithPriority[T]
+// priorityQueue is a priority queue that implements heap
+// and sort interfaces over the items of PriorityQueue.
+type priorityQueue[E any] struct {
+	items   []*Item[E]
+	compare func(a, b E) int
+	max     bool // pop the greatest element first.
+}
 
 // Len is the number of elements in the collection.
-func (pq priorityQueue[T]) Len() int { return len(pq) }
+func (pq priorityQueue[E]) Len() int { return len(pq.items) }
 
 // Less reports whether the element with index i
 // must sort before the element with index j.
-func (pq priorityQueue[T]) Less(i, j int) bool {
-	return pq[i].priority < pq[j].priority
+func (pq priorityQueue[E]) Less(i, j int) bool {
+	c := pq.compare(pq.items[i].value, pq.items[j].value)
+	if pq.max {
+		c = -c
+	}
+
+	if c != 0 {
+		return c < 0
+	}
+
+	return pq.items[i].seq < pq.items[j].seq
 }
 
 // Swap swaps the elements with indexes i and j.
-func (pq priorityQueue[T]) Swap(i, j int) {
-	pq[i], pq[j] = pq[j], pq[i]
-	pq[i].index = i
-	pq[j].index = j
+func (pq priorityQueue[E]) Swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
 }
 
 // Push adds new item to the collection.
-func (pq *priorityQueue[T]) Push(x interface{}) {
-	item, ok := x.(*VertexWithPriority[T])
+func (pq *priorityQueue[E]) Push(x interface{}) {
+	item, ok := x.(*Item[E])
 	if !ok {
 		return
 	}
 
-	n := len(*pq)
-	item.index = n
-	*pq = append(*pq, item)
+	item.index = len(pq.items)
+	pq.items = append(pq.items, item)
 }
 
-// Pop removes and returns the minimum element (according to Less) from the heap.
+// Pop removes and returns the first element (according to Less) from the heap.
 // The complexity is O(log n) where n = h.Len().
 // Pop is equivalent to Remove(h, 0).
-func (pq *priorityQueue[T]) Pop() interface{} {
-	old := *pq
+func (pq *priorityQueue[E]) Pop() interface{} {
+	old := pq.items
 	n := len(old)
 	item := old[n-1]
 	old[n-1] = nil // avoid memory leak
 	item.index = -1
-	*pq = old[0 : n-1]
+	pq.items = old[0 : n-1]
 	return item
 }
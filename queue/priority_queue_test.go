@@ -21,41 +21,53 @@
 package queue
 
 import (
+	"cmp"
 	"container/heap"
 	"reflect"
 	"testing"
 
 	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
 )
 
+// task is an element with a priority and a name, to check the tie-breaking.
+type task struct {
+	name     string
+	priority int
+}
+
+func compareTask(a, b task) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
 func TestPriorityQueue(t *testing.T) {
 	// create a new priority queue
-	pq := make(priorityQueue[string], 0)
+	pq := priorityQueue[*VertexWithPriority[string]]{compare: compareVertexWithPriority[string]}
 
 	// push some items with different priorities to the queue
-	heap.Push(&pq, NewVertexWithPriority(grafik.NewVertex("A"), 3))
-	heap.Push(&pq, NewVertexWithPriority(grafik.NewVertex("B"), 1))
-	heap.Push(&pq, NewVertexWithPriority(grafik.NewVertex("C"), 5))
-	heap.Push(&pq, NewVertexWithPriority(grafik.NewVertex("D"), 2))
-	heap.Push(&pq, NewVertexWithPriority(grafik.NewVertex("E"), 4))
+	heap.Push(&pq, &Item[*VertexWithPriority[string]]{value: NewVertexWithPriority(grafik.NewVertex("A"), 3)})
+	heap.Push(&pq, &Item[*VertexWithPriority[string]]{value: NewVertexWithPriority(grafik.NewVertex("B"), 1)})
+	heap.Push(&pq, &Item[*VertexWithPriority[string]]{value: NewVertexWithPriority(grafik.NewVertex("C"), 5)})
+	heap.Push(&pq, &Item[*VertexWithPriority[string]]{value: NewVertexWithPriority(grafik.NewVertex("D"), 2)})
+	heap.Push(&pq, &Item[*VertexWithPriority[string]]{value: NewVertexWithPriority(grafik.NewVertex("E"), 4)})
 
 	// push different type
 	heap.Push(&pq, 123)
 
 	// check that the length of the priority queue is 5
-	if len(pq) != 5 {
-		t.Errorf("priorityQueue length = %d; want 5", len(pq))
+	if pq.Len() != 5 {
+		t.Errorf("priorityQueue length = %d; want 5", pq.Len())
 	}
 
 	// pop items from the queue and check that they are in the correct order
 	items := make([]string, 0)
 	for pq.Len() > 0 {
 		item := heap.Pop(&pq)
-		vp, ok := item.(*VertexWithPriority[string])
+		vp, ok := item.(*Item[*VertexWithPriority[string]])
 		if !ok {
-			t.Errorf("Expected *VertexWithPriority[string] type, but got %+v", reflect.TypeOf(item))
+			t.Errorf("Expected *Item[*VertexWithPriority[string]] type, but got %+v", reflect.TypeOf(item))
 		}
-		items = append(items, vp.Vertex().Label())
+		items = append(items, vp.Value().Vertex().Label())
 	}
 	expected := []string{"B", "D", "A", "E", "C"}
 	if !reflect.DeepEqual(items, expected) {
@@ -63,43 +75,90 @@ func TestPriorityQueue(t *testing.T) {
 	}
 }
 
-func TestVertexPriorityQueue(t *testing.T) {
-	// create a new vertex priority queue
-	vpq := NewVertexPriorityQueue[string]()
+func TestPriorityQueueMinMax(t *testing.T) {
+	tasks := []task{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 3}, {"e", 1}, {"f", 2}}
 
-	// push some items with different priorities to the queue
-	vpq.Push(NewVertexWithPriority(grafik.NewVertex("A"), 2))
-	vpq.Push(NewVertexWithPriority(grafik.NewVertex("B"), 1))
-	vpq.Push(NewVertexWithPriority(grafik.NewVertex("C"), 3))
+	modes := map[string]struct {
+		opts     []options.PriorityQueueOptionFunc
+		expected []string
+	}{
+		"Min": {expected: []string{"b", "e", "a", "c", "f", "d"}},
+		"Max": {opts: []options.PriorityQueueOptionFunc{options.WithPriorityQueueMax()}, expected: []string{"d", "a", "c", "f", "b", "e"}},
+	}
+
+	for name, mode := range modes {
+		pq := NewPriorityQueue(compareTask, mode.opts...)
+		for _, tk := range tasks {
+			pq.Push(tk)
+		}
+
+		if top, ok := pq.Peek(); !ok || top.name != mode.expected[0] {
+			t.Errorf("Expected %s Peek returns %s, but got %v", name, mode.expected[0], top)
+		}
+
+		// the equal priorities are popped in the order they have been pushed.
+		names := make([]string, 0)
+		for pq.Len() > 0 {
+			tk, _ := pq.Pop()
+			names = append(names, tk.name)
+		}
+
+		if !reflect.DeepEqual(names, mode.expected) {
+			t.Errorf("Expected %s Pop() order = %v; want %v", name, names, mode.expected)
+		}
+
+		if _, ok := pq.Pop(); ok {
+			t.Errorf("Expected %s Pop of empty queue returns false", name)
+		}
 
-	if vpq.Peek().vertex.Label() != "B" {
-		t.Errorf("Expected Peek returns B, but got %v", vpq.Peek().vertex.Label())
+		if _, ok := pq.Peek(); ok {
+			t.Errorf("Expected %s Peek of empty queue returns false", name)
+		}
 	}
+}
 
-	// check that the length of the priority queue is 5
-	if vpq.Len() != 3 {
-		t.Errorf("VertexPriorityQueue length = %d; want 5", len(vpq.pq))
+func TestPriorityQueueItems(t *testing.T) {
+	pq := NewPriorityQueue(compareTask)
+
+	a := pq.Push(task{"a", 5})
+	b := pq.Push(task{"b", 3})
+	c := pq.Push(task{"c", 4})
+	d := pq.Push(task{"d", 3})
+
+	if !pq.Contains(a) || a.Value().name != "a" {
+		t.Errorf("Expected the queue to contain a, but got %v", a.Value())
 	}
 
-	// pop items from the queue and check that they are in the correct order
-	items := make([]string, 0)
-	priorities := make([]float64, 0)
-	for vpq.pq.Len() > 0 {
-		item := vpq.Pop()
-		items = append(items, item.Vertex().Label())
-		priorities = append(priorities, item.Priority())
+	// an updated item keeps its place among the equal elements.
+	if !pq.Update(a, task{"a", 3}) {
+		t.Errorf("Expected Update of a returns true")
 	}
-	expectedVertices := []string{"B", "A", "C"}
-	if !reflect.DeepEqual(items, expectedVertices) {
-		t.Errorf("VertexPriorityQueue Pop() order = %v; want %v", items, expectedVertices)
+
+	if value, ok := pq.Remove(c); !ok || value.name != "c" || pq.Contains(c) {
+		t.Errorf("Expected Remove returns c, but got %v", value)
+	}
+
+	if _, ok := pq.Remove(c); ok || pq.Update(c, task{"c", 0}) || pq.Fix(c) {
+		t.Errorf("Expected removed item to be ignored")
+	}
+
+	// an item of another queue isn't in this one.
+	if other := NewPriorityQueue(compareTask); other.Contains(b) || other.Fix(b) {
+		t.Errorf("Expected item of another queue to be ignored")
+	}
+
+	names := make([]string, 0)
+	for pq.Len() > 0 {
+		tk, _ := pq.Pop()
+		names = append(names, tk.name)
 	}
 
-	expectedPriorities := []float64{1, 2, 3}
-	if !reflect.DeepEqual(priorities, expectedPriorities) {
-		t.Errorf("VertexPriorityQueue Pop() order = %v; want %v", priorities, expectedPriorities)
+	expected := []string{"a", "b", "d"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("PriorityQueue Pop() order = %v; want %v", names, expected)
 	}
 
-	if vpq.Peek() != nil {
-		t.Errorf("Expected Peek returns nil, but got %v", vpq.Peek())
+	if pq.Contains(d) {
+		t.Errorf("Expected popped item not to be contained")
 	}
 }
@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import (
+	"cmp"
+
+	"github.com/fitm-elite/grafik"
+)
+
+// VertexPriorityQueue wraps PriorityQueue to hold vertices with their
+// priorities, and pops the vertex with the minimum priority first. It
+// keeps at most one item per vertex label, so the priority of a queued
+// vertex can be updated in place instead of pushing a duplicate item.
+type VertexPriorityQueue[T comparable] struct {
+	pq    *PriorityQueue[*VertexWithPriority[T]] // a min heap of VertexWithPriority.
+	items map[T]*Item[*VertexWithPriority[T]]    // the queued items by vertex label.
+}
+
+func NewVertexPriorityQueue[T comparable]() *VertexPriorityQueue[T] {
+	return &VertexPriorityQueue[T]{
+		pq:    NewPriorityQueue(compareVertexWithPriority[T]),
+		items: make(map[T]*Item[*VertexWithPriority[T]]),
+	}
+}
+
+// compareVertexWithPriority compares the items by their priorities.
+func compareVertexWithPriority[T comparable](a, b *VertexWithPriority[T]) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
+// Push adds new VertexWithPriority to the queue. If a vertex with the
+// same label is already in the queue, Push lowers its priority as
+// DecreaseKey does, and ignores a priority that isn't lower, so the queued
+// priority is never raised.
+func (v *VertexPriorityQueue[T]) Push(in *VertexWithPriority[T]) {
+	if v.Contains(in.vertex.Label()) {
+		v.DecreaseKey(in.vertex.Label(), in.priority)
+		return
+	}
+
+	v.items[in.vertex.Label()] = v.pq.Push(in)
+}
+
+// Pop removes and returns the vertex with the minimum priority from
+// the underlying queue. If the queue is empty, returns nil.
+func (v *VertexPriorityQueue[T]) Pop() *VertexWithPriority[T] {
+	out, ok := v.pq.Pop()
+	if !ok {
+		return nil
+	}
+
+	delete(v.items, out.vertex.Label())
+
+	return out
+}
+
+// Peek returns the vertex with the minimum priority without removing
+// it from the underlying queue. If the queue is empty, returns nil.
+func (v *VertexPriorityQueue[T]) Peek() *VertexWithPriority[T] {
+	out, _ := v.pq.Peek()
+	return out
+}
+
+// Len is the number of elements in the underlying queue.
+func (v *VertexPriorityQueue[T]) Len() int {
+	return v.pq.Len()
+}
+
+// Contains returns 'true' if the vertex with the input label is in the queue.
+func (v *VertexPriorityQueue[T]) Contains(label T) bool {
+	_, ok := v.items[label]
+	return ok
+}
+
+// Update changes the priority of the vertex with the input label, and
+// moves it to its new position in the underlying queue.
+//
+// It returns 'false' if the vertex isn't in the queue.
+func (v *VertexPriorityQueue[T]) Update(label T, priority float64) bool {
+	item, ok := v.items[label]
+	if !ok {
+		return false
+	}
+
+	item.Value().priority = priority
+
+	return v.pq.Fix(item)
+}
+
+// DecreaseKey lowers the priority of the vertex with the input label, as
+// Dijkstra's algorithm does when it finds a shorter path.
+//
+// It returns 'false' if the vertex isn't in the queue, or if the input
+// priority isn't lower than the current one.
+func (v *VertexPriorityQueue[T]) DecreaseKey(label T, priority float64) bool {
+	item, ok := v.items[label]
+	if !ok || priority >= item.Value().priority {
+		return false
+	}
+
+	item.Value().priority = priority
+
+	return v.pq.Fix(item)
+}
+
+// Remove removes and returns the vertex with the input label from the queue.
+//
+// It returns 'false' if the vertex isn't in the queue.
+func (v *VertexPriorityQueue[T]) Remove(label T) (*VertexWithPriority[T], bool) {
+	item, ok := v.items[label]
+	if !ok {
+		return nil, false
+	}
+
+	delete(v.items, label)
+
+	return v.pq.Remove(item)
+}
+
+// VertexWithPriority is a vertex priority queue item that stores
+// vertex along with its priority.
+type VertexWithPriority[T comparable] struct {
+	vertex   *grafik.Vertex[T]
+	priority float64
+}
+
+func NewVertexWithPriority[T comparable](vertex *grafik.Vertex[T], priority float64) *VertexWithPriority[T] {
+	return &VertexWithPriority[T]{vertex: vertex, priority: priority}
+}
+
+// Priority returns the priority of the vertex.
+func (v VertexWithPriority[T]) Priority() float64 {
+	return v.priority
+}
+
+// Vertex returns the vertex.
+func (v VertexWithPriority[T]) Vertex() *grafik.Vertex[T] {
+	return v.vertex
+}
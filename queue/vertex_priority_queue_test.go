@@ -0,0 +1,213 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestVertexPriorityQueue(t *testing.T) {
+	// create a new vertex priority queue
+	vpq := NewVertexPriorityQueue[string]()
+
+	// push some items with different priorities to the queue
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("A"), 2))
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("B"), 1))
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("C"), 3))
+
+	if vpq.Peek().vertex.Label() != "B" {
+		t.Errorf("Expected Peek returns B, but got %v", vpq.Peek().vertex.Label())
+	}
+
+	// check that the length of the priority queue is 3
+	if vpq.Len() != 3 {
+		t.Errorf("VertexPriorityQueue length = %d; want 3", vpq.Len())
+	}
+
+	// pop items from the queue and check that they are in the correct order
+	items := make([]string, 0)
+	priorities := make([]float64, 0)
+	for vpq.Len() > 0 {
+		item := vpq.Pop()
+		items = append(items, item.Vertex().Label())
+		priorities = append(priorities, item.Priority())
+	}
+	expectedVertices := []string{"B", "A", "C"}
+	if !reflect.DeepEqual(items, expectedVertices) {
+		t.Errorf("VertexPriorityQueue Pop() order = %v; want %v", items, expectedVertices)
+	}
+
+	expectedPriorities := []float64{1, 2, 3}
+	if !reflect.DeepEqual(priorities, expectedPriorities) {
+		t.Errorf("VertexPriorityQueue Pop() order = %v; want %v", priorities, expectedPriorities)
+	}
+
+	if vpq.Peek() != nil {
+		t.Errorf("Expected Peek returns nil, but got %v", vpq.Peek())
+	}
+}
+
+func TestVertexPriorityQueueUpdate(t *testing.T) {
+	vpq := NewVertexPriorityQueue[string]()
+
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("A"), 2))
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("B"), 4))
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("C"), 3))
+	vpq.Push(NewVertexWithPriority(grafik.NewVertex("D"), 5))
+
+	if !vpq.Contains("B") || vpq.Contains("X") {
+		t.Errorf("Expected Contains to find B and not X")
+	}
+
+	if !vpq.DecreaseKey("B", 1) || vpq.Peek().Vertex().Label() != "B" {
+		t.Errorf("Expected DecreaseKey to move B to the top, but got %v", vpq.Peek().Vertex().Label())
+	}
+
+	if vpq.DecreaseKey("B", 6) || vpq.DecreaseKey("X", 0) {
+		t.Errorf("Expected DecreaseKey to ignore higher priorities and missing vertices")
+	}
+
+	if !vpq.Update("A", 7) || vpq.Update("X", 0) {
+		t.Errorf("Expected Update to change A and ignore X")
+	}
+
+	vpq.DecreaseKey("D", 0)
+	if vpq.Len() != 4 || vpq.Peek().Vertex().Label() != "D" {
+		t.Errorf("Expected 4 items with D on top, but got %d with %v", vpq.Len(), vpq.Peek().Vertex().Label())
+	}
+
+	item, ok := vpq.Remove("C")
+	if !ok || item.Vertex().Label() != "C" || vpq.Contains("C") {
+		t.Errorf("Expected Remove to return C, but got %v", item)
+	}
+
+	if _, ok = vpq.Remove("C"); ok {
+		t.Errorf("Expected Remove to ignore missing vertex")
+	}
+
+	items := make([]string, 0)
+	for vpq.Len() > 0 {
+		items = append(items, vpq.Pop().Vertex().Label())
+	}
+
+	expected := []string{"D", "B", "A"}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("VertexPriorityQueue Pop() order = %v; want %v", items, expected)
+	}
+
+	if vpq.Contains("D") {
+		t.Errorf("Expected popped vertex not to be contained")
+	}
+}
+
+// newRandomGrafik returns a weighted graph with random edges between the
+// vertices, in which shorter paths are often found to queued vertices.
+func newRandomGrafik(size, edges int) grafik.Grafik[int] {
+	rnd := rand.New(rand.NewSource(1))
+
+	g := grafik.New[int]()
+	for i := 0; i < size; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 0; i < edges; i++ {
+		from, to := g.GetVertexByLabel(rnd.Intn(size)), g.GetVertexByLabel(rnd.Intn(size))
+		if from != to {
+			_, _ = g.AddEdge(from, to, options.WithEdgeWeight(float64(rnd.Intn(1000))))
+		}
+	}
+
+	return g
+}
+
+// BenchmarkDijkstraDecreaseKey runs Dijkstra's algorithm that decreases
+// the priority of queued vertices, and reports the largest heap size.
+func BenchmarkDijkstraDecreaseKey(b *testing.B) {
+	g := newRandomGrafik(2000, 40000)
+	maxLen := 0
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dist := map[int]float64{0: 0}
+		vpq := NewVertexPriorityQueue[int]()
+		vpq.Push(NewVertexWithPriority(g.GetVertexByLabel(0), 0))
+
+		for vpq.Len() > 0 {
+			maxLen = max(maxLen, vpq.Len())
+
+			curr := vpq.Pop()
+			for _, neighbor := range curr.Vertex().Neighbors() {
+				alt := curr.Priority() + g.GetEdge(curr.Vertex(), neighbor).Weight()
+				if d, ok := dist[neighbor.Label()]; !ok || alt < d {
+					dist[neighbor.Label()] = alt
+					if !vpq.DecreaseKey(neighbor.Label(), alt) {
+						vpq.Push(NewVertexWithPriority(neighbor, alt))
+					}
+				}
+			}
+		}
+	}
+
+	b.ReportMetric(float64(maxLen), "max-heap-size")
+}
+
+// BenchmarkDijkstraLazyDeletion runs Dijkstra's algorithm that queues a
+// vertex again for each shorter path and skips the stale items, and
+// reports the largest heap size.
+func BenchmarkDijkstraLazyDeletion(b *testing.B) {
+	g := newRandomGrafik(2000, 40000)
+	maxLen := 0
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dist := map[int]float64{0: 0}
+		pq := NewPriorityQueue(compareVertexWithPriority[int])
+		pq.Push(NewVertexWithPriority(g.GetVertexByLabel(0), 0))
+
+		for pq.Len() > 0 {
+			maxLen = max(maxLen, pq.Len())
+
+			curr, _ := pq.Pop()
+			if curr.Priority() > dist[curr.Vertex().Label()] {
+				continue
+			}
+
+			for _, neighbor := range curr.Vertex().Neighbors() {
+				alt := curr.Priority() + g.GetEdge(curr.Vertex(), neighbor).Weight()
+				if d, ok := dist[neighbor.Label()]; !ok || alt < d {
+					dist[neighbor.Label()] = alt
+					pq.Push(NewVertexWithPriority(neighbor, alt))
+				}
+			}
+		}
+	}
+
+	b.ReportMetric(float64(maxLen), "max-heap-size")
+}
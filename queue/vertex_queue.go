@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import "github.com/fitm-elite/grafik/options"
+
+// VertexQueue represents a min priority queue of vertices that keeps at
+// most one item per vertex label. It is implemented by VertexPriorityQueue,
+// which is a binary heap, and by the pairing heap, the d-ary heap and the
+// bucket queue, so algorithms such as Dijkstra can pick the one that suits
+// their graphs.
+type VertexQueue[T comparable] interface {
+	// Push adds the item to the queue. If a vertex with the same label is
+	// already in the queue, Push lowers its priority as DecreaseKey does,
+	// and ignores a priority that isn't lower, so the queued priority is
+	// never raised.
+	Push(in *VertexWithPriority[T])
+
+	// Pop removes and returns the item with the minimum priority.
+	// If the queue is empty, returns nil.
+	Pop() *VertexWithPriority[T]
+
+	// Peek returns the item with the minimum priority without removing it.
+	// If the queue is empty, returns nil.
+	Peek() *VertexWithPriority[T]
+
+	// Len returns the number of items in the queue.
+	Len() int
+
+	// Contains returns 'true' if the vertex with the input label is in the queue.
+	Contains(label T) bool
+
+	// DecreaseKey lowers the priority of the vertex with the input label.
+	//
+	// It returns 'false' if the vertex isn't in the queue, or if the input
+	// priority isn't lower than the current one.
+	DecreaseKey(label T, priority float64) bool
+}
+
+// NewVertexQueue returns the VertexQueue selected by the dijkstra
+// properties, which is VertexPriorityQueue by default.
+func NewVertexQueue[T comparable](properties options.DijkstraProperties) VertexQueue[T] {
+	switch properties.GetQueue() {
+	case options.PairingHeapQueue:
+		return NewPairingHeap[T]()
+	case options.DaryHeapQueue:
+		return NewDaryHeap[T](properties.GetArity())
+	case options.BucketQueue:
+		return NewBucketQueue[T]()
+	default:
+		return NewVertexPriorityQueue[T]()
+	}
+}
+
+var (
+	_ VertexQueue[int] = (*VertexPriorityQueue[int])(nil)
+	_ VertexQueue[int] = (*PairingHeap[int])(nil)
+	_ VertexQueue[int] = (*DaryHeap[int])(nil)
+	_ VertexQueue[int] = (*BucketQueue[int])(nil)
+)
@@ -0,0 +1,181 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+)
+
+// vertexQueues returns a constructor of each VertexQueue implementation.
+func vertexQueues() map[string]func() VertexQueue[int] {
+	return map[string]func() VertexQueue[int]{
+		"BinaryHeap":  func() VertexQueue[int] { return NewVertexPriorityQueue[int]() },
+		"PairingHeap": func() VertexQueue[int] { return NewPairingHeap[int]() },
+		"DaryHeap":    func() VertexQueue[int] { return NewDaryHeap[int](4) },
+		"BucketQueue": func() VertexQueue[int] { return NewBucketQueue[int]() },
+	}
+}
+
+func TestVertexQueues(t *testing.T) {
+	for name, newQueue := range vertexQueues() {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			vq := newQueue()
+			expected := make(map[int]float64)
+
+			// mix pushes, decrease-keys and pops, and compare them with a map.
+			for i := 0; i < 5000; i++ {
+				label := rnd.Intn(200)
+				priority := float64(rnd.Intn(100))
+
+				switch rnd.Intn(4) {
+				case 0:
+					// pushing a queued vertex keeps the lower priority.
+					vq.Push(NewVertexWithPriority(grafik.NewVertex(label), priority))
+					if p, ok := expected[label]; !ok || priority < p {
+						expected[label] = priority
+					}
+				case 1:
+					p, ok := expected[label]
+					decreased := ok && priority < p
+					if vq.DecreaseKey(label, priority) != decreased {
+						t.Fatalf("Expected DecreaseKey(%d, %v) to return %v", label, priority, decreased)
+					}
+
+					if decreased {
+						expected[label] = priority
+					}
+				default:
+					item := vq.Pop()
+					if len(expected) == 0 {
+						if item != nil {
+							t.Fatalf("Expected Pop returns nil, but got %v", item)
+						}
+
+						continue
+					}
+
+					for _, p := range expected {
+						if p < item.Priority() {
+							t.Fatalf("Expected Pop returns the minimum priority %v, but got %v", p, item.Priority())
+						}
+					}
+
+					if expected[item.Vertex().Label()] != item.Priority() {
+						t.Fatalf("Expected priority of %d to be %v, but got %v", item.Vertex().Label(), expected[item.Vertex().Label()], item.Priority())
+					}
+
+					delete(expected, item.Vertex().Label())
+				}
+
+				if vq.Len() != len(expected) {
+					t.Fatalf("Expected %d items, but got %d", len(expected), vq.Len())
+				}
+
+				if _, ok := expected[label]; vq.Contains(label) != ok {
+					t.Fatalf("Expected Contains(%d) to be %v", label, ok)
+				}
+			}
+
+			priorities := make([]float64, 0, len(expected))
+			for _, p := range expected {
+				priorities = append(priorities, p)
+			}
+
+			sort.Float64s(priorities)
+			for _, p := range priorities {
+				if vq.Peek().Priority() != p {
+					t.Fatalf("Expected Peek returns priority %v, but got %v", p, vq.Peek().Priority())
+				}
+
+				if vq.Pop().Priority() != p {
+					t.Fatalf("Expected Pop returns priority %v", p)
+				}
+			}
+
+			if vq.Peek() != nil || vq.Pop() != nil {
+				t.Errorf("Expected empty queue to return nil")
+			}
+		})
+	}
+}
+
+func TestVertexQueuesShareItems(t *testing.T) {
+	items := make([]*VertexWithPriority[int], 0)
+	for i, priority := range []float64{5, 3, 8, 1, 6, 2, 7} {
+		items = append(items, NewVertexWithPriority(grafik.NewVertex(i), priority))
+	}
+
+	// every queue keeps its own positions, so the same items can be in all of them.
+	queues := make(map[string]VertexQueue[int])
+	for name, newQueue := range vertexQueues() {
+		queues[name] = newQueue()
+		for _, item := range items {
+			queues[name].Push(item)
+		}
+	}
+
+	for name, vq := range queues {
+		priorities := make([]float64, 0, len(items))
+		for vq.Len() > 0 {
+			priorities = append(priorities, vq.Pop().Priority())
+		}
+
+		if !sort.Float64sAreSorted(priorities) || len(priorities) != len(items) {
+			t.Errorf("Expected %s to pop %d sorted priorities, but got %v", name, len(items), priorities)
+		}
+	}
+}
+
+// BenchmarkVertexQueues runs Dijkstra's algorithm with each VertexQueue
+// implementation on a sparse graph with integer weights.
+func BenchmarkVertexQueues(b *testing.B) {
+	g := newRandomGrafik(20000, 80000)
+
+	for name, newQueue := range vertexQueues() {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				dist := map[int]float64{0: 0}
+				vq := newQueue()
+				vq.Push(NewVertexWithPriority(g.GetVertexByLabel(0), 0))
+
+				for vq.Len() > 0 {
+					curr := vq.Pop()
+					for _, neighbor := range curr.Vertex().Neighbors() {
+						alt := curr.Priority() + g.GetEdge(curr.Vertex(), neighbor).Weight()
+						if d, ok := dist[neighbor.Label()]; !ok || alt < d {
+							dist[neighbor.Label()] = alt
+							if !vq.DecreaseKey(neighbor.Label(), alt) {
+								vq.Push(NewVertexWithPriority(neighbor, alt))
+							}
+						}
+					}
+				}
+			}
+		})
+	}
+}
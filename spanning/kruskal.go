@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package spanning
+
+import (
+	"sort"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/unionfind"
+)
+
+// Kruskal finds a minimum spanning forest of the undirected graph using
+// Kruskal's algorithm. It adds the edges in the order of their weights,
+// skipping the ones that would form a cycle, which are detected with a
+// union-find structure.
+//
+// The time complexity of Kruskal's algorithm is O(E*log(E)).
+//
+// If the graph is directed, returns ErrDirectedGraph.
+func Kruskal[T comparable](g grafik.Grafik[T]) (*Forest[T], error) {
+	g = grafik.Snapshot(g)
+
+	if g.IsDirected() {
+		return nil, ErrDirectedGraph
+	}
+
+	vertices := g.GetAllVertices()
+	sets := unionfind.New[T]()
+
+	// collect each undirected edge once.
+	edges := make([]*grafik.Edge[T], 0, len(vertices))
+	written := make(map[T]map[T]bool)
+	for _, v := range vertices {
+		sets.Add(v.Label())
+
+		for _, neighbor := range v.Neighbors() {
+			if written[neighbor.Label()][v.Label()] {
+				continue
+			}
+
+			if _, ok := written[v.Label()]; !ok {
+				written[v.Label()] = make(map[T]bool)
+			}
+
+			written[v.Label()][neighbor.Label()] = true
+			edges = append(edges, g.GetEdge(v, neighbor))
+		}
+	}
+
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight() < edges[j].Weight()
+	})
+
+	forest := &Forest[T]{graph: g}
+	for _, edge := range edges {
+		if sets.Union(edge.Source().Label(), edge.Destination().Label()) {
+			forest.add(edge)
+		}
+	}
+
+	forest.trees = sets.Sets()
+
+	return forest, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package spanning
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestKruskal(t *testing.T) {
+	// two components: A B C D with a minimum spanning tree of weight 6,
+	// E F with a single edge of weight 5, and the lone vertex G.
+	g := grafik.New[string]()
+	for _, label := range []string{"A", "B", "C", "D", "E", "F", "G"} {
+		g.AddVertexByLabel(label)
+	}
+
+	for _, e := range []struct {
+		from, to string
+		weight   float64
+	}{{"A", "B", 1}, {"B", "C", 2}, {"A", "C", 4}, {"C", "D", 3}, {"B", "D", 5}, {"E", "F", 5}} {
+		if _, err := g.AddEdge(g.GetVertexByLabel(e.from), g.GetVertexByLabel(e.to), options.WithEdgeWeight(e.weight)); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	forest, err := Kruskal(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if forest.Weight() != 11 {
+		t.Errorf("Expected total weight 11, got %f", forest.Weight())
+	}
+
+	if len(forest.Edges()) != 4 {
+		t.Errorf("Expected 4 edges, got %d", len(forest.Edges()))
+	}
+
+	if forest.Trees() != 3 || forest.IsTree() {
+		t.Errorf("Expected a forest of 3 trees, got %d", forest.Trees())
+	}
+
+	if _, err = Kruskal(grafik.New[string](options.WithDirected())); !errors.Is(err, ErrDirectedGraph) {
+		t.Errorf("Expected %s, but got %v", ErrDirectedGraph, err)
+	}
+
+	forest, err = Kruskal(grafik.New[string]())
+	if err != nil || forest.Weight() != 0 || len(forest.Edges()) != 0 {
+		t.Errorf("Expected empty forest for empty graph, but got %v", err)
+	}
+}
+
+func TestKruskalRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	g := grafik.New[int]()
+	for i := 0; i < 50; i++ {
+		g.AddVertexByLabel(i)
+	}
+
+	for i := 1; i < 50; i++ {
+		_, _ = g.AddEdge(g.GetVertexByLabel(rnd.Intn(i)), g.GetVertexByLabel(i), options.WithEdgeWeight(float64(rnd.Intn(100))))
+	}
+
+	for i := 0; i < 200; i++ {
+		from, to := rnd.Intn(50), rnd.Intn(50)
+		if from != to {
+			_, _ = g.AddEdge(g.GetVertexByLabel(from), g.GetVertexByLabel(to), options.WithEdgeWeight(float64(rnd.Intn(100))))
+		}
+	}
+
+	forest, err := Kruskal(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if !forest.IsTree() || len(forest.Edges()) != 49 {
+		t.Errorf("Expected a spanning tree of 49 edges, got %d edges in %d trees", len(forest.Edges()), forest.Trees())
+	}
+
+	expected, _ := Prim(g)
+	if forest.Weight() != expected.Weight() {
+		t.Errorf("Expected total weight %f, got %f", expected.Weight(), forest.Weight())
+	}
+}
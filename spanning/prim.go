@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package spanning
+
+import (
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+	"github.com/fitm-elite/grafik/queue"
+)
+
+// Prim finds a minimum spanning forest of the undirected graph using
+// Prim's algorithm. It grows a tree from a vertex of each connected
+// component by adding the cheapest edge to a vertex outside of the tree,
+// which is selected with a priority queue. The options select the priority
+// queue, as they do for Dijkstra.
+//
+// The time complexity of Prim's algorithm is O(E*log(V)).
+//
+// If the graph is directed, returns ErrDirectedGraph.
+func Prim[T comparable](g grafik.Grafik[T], opts ...options.DijkstraOptionFunc) (*Forest[T], error) {
+	g = grafik.Snapshot(g)
+
+	if g.IsDirected() {
+		return nil, ErrDirectedGraph
+	}
+
+	var properties options.DijkstraProperties
+	for _, opt := range opts {
+		opt(&properties)
+	}
+
+	forest := &Forest[T]{graph: g}
+	inTree := make(map[T]bool)
+
+	// cheapest keeps the cheapest known edge from the tree to each vertex outside of it.
+	cheapest := make(map[T]*grafik.Edge[T])
+
+	for _, root := range g.GetAllVertices() {
+		if inTree[root.Label()] {
+			continue
+		}
+
+		forest.trees++
+
+		pq := queue.NewVertexQueue[T](properties)
+		pq.Push(queue.NewVertexWithPriority(root, 0))
+
+		for pq.Len() > 0 {
+			u := pq.Pop().Vertex()
+			inTree[u.Label()] = true
+			if edge, ok := cheapest[u.Label()]; ok {
+				forest.add(edge)
+			}
+
+			for _, neighbor := range u.Neighbors() {
+				if inTree[neighbor.Label()] {
+					continue
+				}
+
+				edge := g.GetEdge(u, neighbor)
+				if best, ok := cheapest[neighbor.Label()]; ok && best.Weight() <= edge.Weight() {
+					continue
+				}
+
+				cheapest[neighbor.Label()] = edge
+				if !pq.DecreaseKey(neighbor.Label(), edge.Weight()) {
+					pq.Push(queue.NewVertexWithPriority(neighbor, edge.Weight()))
+				}
+			}
+		}
+	}
+
+	return forest, nil
+}
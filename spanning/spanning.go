@@ -0,0 +1,100 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package spanning implements minimum spanning tree algorithms for
+// weighted undirected graphs.
+package spanning
+
+import (
+	"errors"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+var ErrDirectedGraph = errors.New("spanning tree of directed graph is not supported")
+
+// Forest represents a minimum spanning forest of a graph, which is a
+// minimum spanning tree for each connected component. If the graph is
+// connected, it is a single minimum spanning tree.
+//
+// On a concurrent graph, the edges belong to the grafik.Snapshot that the
+// forest was found on, so compare them with the edges of the graph by id.
+type Forest[T comparable] struct {
+	graph  grafik.Grafik[T]
+	edges  []*grafik.Edge[T]
+	weight float64
+	trees  int
+}
+
+// Edges returns the edges of the forest, in the order they were added.
+func (f *Forest[T]) Edges() []*grafik.Edge[T] {
+	return f.edges
+}
+
+// Weight returns the sum of the edge weights of the forest.
+func (f *Forest[T]) Weight() float64 {
+	return f.weight
+}
+
+// Trees returns the number of trees in the forest, which is the number of
+// connected components of the graph.
+func (f *Forest[T]) Trees() int {
+	return f.trees
+}
+
+// IsTree returns 'true' if the forest is a single spanning tree, which
+// means the graph is connected.
+func (f *Forest[T]) IsTree() bool {
+	return f.trees <= 1
+}
+
+// Grafik returns the forest as a new undirected graph with all vertices of
+// the original graph and the edges of the forest. The vertex and edge
+// weights and attributes are copied from the original graph.
+func (f *Forest[T]) Grafik() grafik.Grafik[T] {
+	g := grafik.New[T]()
+
+	for _, v := range f.graph.GetAllVertices() {
+		opts := []options.VertexOptionFunc{options.WithVertexWeight(v.Weight())}
+		for key, value := range v.Attrs() {
+			opts = append(opts, options.WithVertexAttr(key, value))
+		}
+
+		g.AddVertexByLabel(v.Label(), opts...)
+	}
+
+	for _, edge := range f.edges {
+		opts := []options.EdgeOptionFunc{options.WithEdgeWeight(edge.Weight())}
+		for key, value := range edge.Attrs() {
+			opts = append(opts, options.WithEdgeAttr(key, value))
+		}
+
+		_, _ = g.AddEdge(g.GetVertexByLabel(edge.Source().Label()), g.GetVertexByLabel(edge.Destination().Label()), opts...)
+	}
+
+	return g
+}
+
+// add adds the edge to the forest.
+func (f *Forest[T]) add(edge *grafik.Edge[T]) {
+	f.edges = append(f.edges, edge)
+	f.weight += edge.Weight()
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package spanning
+
+import (
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestForestGrafik(t *testing.T) {
+	g := grafik.New[string]()
+
+	vA := g.AddVertexByLabel("A", options.WithVertexWeight(2))
+	vB := g.AddVertexByLabel("B")
+	vC := g.AddVertexByLabel("C")
+	vD := g.AddVertexByLabel("D")
+	vE := g.AddVertexByLabel("E")
+	vF := g.AddVertexByLabel("F")
+	_ = g.AddVertexByLabel("G")
+
+	if _, err := g.AddEdge(vA, vB, options.WithEdgeWeight(1), options.WithEdgeAttr("cable", "fiber")); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vB, vC, options.WithEdgeWeight(2)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vA, vC, options.WithEdgeWeight(4)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vC, vD, options.WithEdgeWeight(3)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	if _, err := g.AddEdge(vE, vF, options.WithEdgeWeight(5)); err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	forest, err := Kruskal(g)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %s", err)
+	}
+
+	tree := forest.Grafik()
+	if tree.IsDirected() {
+		t.Errorf("Expected undirected graph, but got directed")
+	}
+
+	if len(tree.GetAllVertices()) != 7 {
+		t.Errorf("Expected 7 vertices, got %d", len(tree.GetAllVertices()))
+	}
+
+	tA, tB, tC := tree.GetVertexByLabel("A"), tree.GetVertexByLabel("B"), tree.GetVertexByLabel("C")
+	if tA.Weight() != 2 {
+		t.Errorf("Expected vertex weight 2, got %f", tA.Weight())
+	}
+
+	edge := tree.GetEdge(tA, tB)
+	if edge == nil || edge.Weight() != 1 {
+		t.Fatalf("Expected edge A-B of weight 1, got %v", edge)
+	}
+
+	if cable, _ := edge.Attr("cable"); cable != "fiber" {
+		t.Errorf("Expected cable attribute fiber, got %v", cable)
+	}
+
+	if tree.ContainsEdge(tA, tC) {
+		t.Errorf("Expected no edge A-C in the spanning tree")
+	}
+}
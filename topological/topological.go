@@ -0,0 +1,163 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package topological implements sorting the vertices of a directed acyclic
+// graph, so that every edge goes from an earlier vertex to a later one.
+package topological
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/cycle"
+	"github.com/fitm-elite/grafik/internal/dfs"
+)
+
+var (
+	ErrCycle           = errors.New("graph has a cycle")
+	ErrUndirectedGraph = errors.New("topological sort of undirected graph is not supported")
+)
+
+// CycleError is returned when the graph can't be sorted because of a
+// cycle. It carries the labels of the vertices on one cycle, in the order
+// of the edges. The first vertex is not repeated at the end.
+//
+// It matches ErrCycle when using errors.Is.
+type CycleError[T comparable] struct {
+	Cycle []T
+}
+
+// Error returns the error message including the cycle.
+func (e *CycleError[T]) Error() string {
+	return fmt.Sprintf("%s: %v", ErrCycle, e.Cycle)
+}
+
+// Is reports whether the target is ErrCycle.
+func (e *CycleError[T]) Is(target error) bool {
+	return target == ErrCycle
+}
+
+// Kahn sorts the vertices of the directed graph using Kahn's algorithm. It
+// repeatedly takes a vertex without incoming edges, starting from the
+// in degree of each vertex, and removes its outgoing edges. When several
+// vertices are ready at the same time, any of them may come first.
+//
+// The time complexity of Kahn's algorithm is O(V+E).
+//
+// If the graph is undirected, returns ErrUndirectedGraph.
+// If the graph has a cycle, returns *CycleError.
+func Kahn[T comparable](g grafik.Grafik[T]) ([]T, error) {
+	g = grafik.Snapshot(g)
+
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	vertices := g.GetAllVertices()
+
+	inDegree := make(map[T]int, len(vertices))
+	ready := make([]*grafik.Vertex[T], 0)
+	for _, v := range vertices {
+		inDegree[v.Label()] = v.InDegree()
+		if v.InDegree() == 0 {
+			ready = append(ready, v)
+		}
+	}
+
+	order := make([]T, 0, len(vertices))
+	for head := 0; head < len(ready); head++ {
+		v := ready[head]
+		order = append(order, v.Label())
+
+		for _, neighbor := range v.Neighbors() {
+			inDegree[neighbor.Label()]--
+			if inDegree[neighbor.Label()] == 0 {
+				ready = append(ready, neighbor)
+			}
+		}
+	}
+
+	// the vertices that never became ready are on, or after, a cycle.
+	if len(order) < len(vertices) {
+		c, _ := cycle.FindCycle(g)
+		return nil, &CycleError[T]{Cycle: c}
+	}
+
+	return order, nil
+}
+
+// DepthFirst sorts the vertices of the directed graph using a depth-first
+// search. A vertex comes before all vertices reachable from it, so the
+// reverse of the order in which the searches finish is a topological order.
+//
+// The time complexity of the depth-first sort is O(V+E).
+//
+// If the graph is undirected, returns ErrUndirectedGraph.
+// If the graph has a cycle, returns *CycleError.
+func DepthFirst[T comparable](g grafik.Grafik[T]) ([]T, error) {
+	g = grafik.Snapshot(g)
+
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	vertices := g.GetAllVertices()
+
+	// onStack marks the vertices being searched, and done the finished ones.
+	onStack := make(map[T]bool)
+	done := make(map[T]bool)
+	order := make([]T, 0, len(vertices))
+
+	for _, root := range vertices {
+		if done[root.Label()] {
+			continue
+		}
+
+		onStack[root.Label()] = true
+		frames := []*dfs.Frame[T]{dfs.NewFrame(root)}
+		for len(frames) > 0 {
+			frame := frames[len(frames)-1]
+			neighbor, ok := frame.Next()
+			if !ok {
+				onStack[frame.Vertex().Label()] = false
+				done[frame.Vertex().Label()] = true
+				order = append(order, frame.Vertex().Label())
+				frames = frames[:len(frames)-1]
+
+				continue
+			}
+
+			switch {
+			case onStack[neighbor.Label()]:
+				return nil, &CycleError[T]{Cycle: dfs.CycleOnStack(frames, neighbor.Label())}
+			case !done[neighbor.Label()]:
+				onStack[neighbor.Label()] = true
+				frames = append(frames, dfs.NewFrame(neighbor))
+			}
+		}
+	}
+
+	// the vertices are collected in finish order, so reverse them.
+	slices.Reverse(order)
+
+	return order, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topological
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fitm-elite/grafik"
+	"github.com/fitm-elite/grafik/options"
+)
+
+func TestSort(t *testing.T) {
+	// a dependency graph of build steps.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range [][2]string{
+		{"fetch", "generate"}, {"fetch", "compile"}, {"generate", "compile"}, {"compile", "test"},
+		{"fetch", "lint"}, {"test", "release"}, {"lint", "release"},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e[0]), grafik.NewVertex(e[1])); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	for name, sort := range map[string]func(grafik.Grafik[string]) ([]string, error){"Kahn": Kahn[string], "DepthFirst": DepthFirst[string]} {
+		order, err := sort(g)
+		if err != nil {
+			t.Fatalf("Expected no error from %s, but got %s", name, err)
+		}
+
+		if len(order) != 6 {
+			t.Fatalf("Expected %s order of 6 vertices, got %v", name, order)
+		}
+
+		position := make(map[string]int)
+		for i, label := range order {
+			position[label] = i
+		}
+
+		for _, v := range g.GetAllVertices() {
+			for _, neighbor := range v.Neighbors() {
+				if position[v.Label()] > position[neighbor.Label()] {
+					t.Errorf("Expected %s to come before %s in %s order %v", v.Label(), neighbor.Label(), name, order)
+				}
+			}
+		}
+	}
+}
+
+func TestSortCycle(t *testing.T) {
+	// a dependency graph of build steps with the cycle generate -> compile -> test.
+	g := grafik.New[string](options.WithDirected())
+	for _, e := range [][2]string{
+		{"fetch", "generate"}, {"fetch", "compile"}, {"generate", "compile"}, {"compile", "test"},
+		{"fetch", "lint"}, {"test", "release"}, {"lint", "release"}, {"test", "generate"},
+	} {
+		if _, err := g.AddEdge(grafik.NewVertex(e[0]), grafik.NewVertex(e[1])); err != nil {
+			t.Fatalf("Expected no error, but got %s", err)
+		}
+	}
+
+	for name, sort := range map[string]func(grafik.Grafik[string]) ([]string, error){"Kahn": Kahn[string], "DepthFirst": DepthFirst[string]} {
+		_, err := sort(g)
+		if !errors.Is(err, ErrCycle) {
+			t.Errorf("Expected %s from %s, but got %v", ErrCycle, name, err)
+		}
+
+		var cycleErr *CycleError[string]
+		if !errors.As(err, &cycleErr) {
+			t.Fatalf("Expected *CycleError from %s, but got %T", name, err)
+		}
+
+		if len(cycleErr.Cycle) != 3 {
+			t.Errorf("Expected %s cycle of 3 vertices, got %v", name, cycleErr.Cycle)
+		}
+
+		for i, label := range cycleErr.Cycle {
+			from := g.GetVertexByLabel(label)
+			to := g.GetVertexByLabel(cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)])
+			if !g.ContainsEdge(from, to) {
+				t.Errorf("Expected edge %s -> %s in %s cycle %v", label, cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)], name, cycleErr.Cycle)
+			}
+		}
+	}
+}
+
+func TestSortOfUndirected(t *testing.T) {
+	g := grafik.New[string]()
+
+	if _, err := Kahn(g); !errors.Is(err, ErrUndirectedGraph) {
+		t.Errorf("Expected %s, but got %v", ErrUndirectedGraph, err)
+	}
+
+	if _, err := DepthFirst(g); !errors.Is(err, ErrUndirectedGraph) {
+		t.Errorf("Expected %s, but got %v", ErrUndirectedGraph, err)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package unionfind implements a disjoint-set data structure, which keeps
+// track of elements partitioned into sets and merges those sets.
+package unionfind
+
+// UnionFind represents disjoint sets of elements. It uses path compression
+// and union by rank, so its operations run in nearly constant amortized time.
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	sets   int
+}
+
+func New[T comparable](elements ...T) *UnionFind[T] {
+	u := &UnionFind[T]{
+		parent: make(map[T]T, len(elements)),
+		rank:   make(map[T]int, len(elements)),
+	}
+
+	for _, element := range elements {
+		u.Add(element)
+	}
+
+	return u
+}
+
+// Add adds the element as a new set with only that element.
+//
+// It returns 'false' if the element already exists.
+func (u *UnionFind[T]) Add(element T) bool {
+	if _, ok := u.parent[element]; ok {
+		return false
+	}
+
+	u.parent[element] = element
+	u.sets++
+
+	return true
+}
+
+// Contains returns 'true' if the element has been added.
+func (u *UnionFind[T]) Contains(element T) bool {
+	_, ok := u.parent[element]
+	return ok
+}
+
+// Find returns the representative element of the set that contains the
+// input element. Elements of the same set have the same representative.
+//
+// If the element doesn't exist, it is added as a new set first.
+func (u *UnionFind[T]) Find(element T) T {
+	u.Add(element)
+
+	root := element
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+
+	// compress the path, so the next finds go straight to the root.
+	for element != root {
+		next := u.parent[element]
+		u.parent[element] = root
+		element = next
+	}
+
+	return root
+}
+
+// Union merges the sets that contain the input elements.
+//
+// It returns 'false' if the elements are already in the same set.
+func (u *UnionFind[T]) Union(a, b T) bool {
+	rootA, rootB := u.Find(a), u.Find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	// attach the shorter tree under the taller one.
+	switch {
+	case u.rank[rootA] < u.rank[rootB]:
+		u.parent[rootA] = rootB
+	case u.rank[rootA] > u.rank[rootB]:
+		u.parent[rootB] = rootA
+	default:
+		u.parent[rootB] = rootA
+		u.rank[rootA]++
+	}
+
+	u.sets--
+
+	return true
+}
+
+// Connected returns 'true' if the input elements are in the same set.
+func (u *UnionFind[T]) Connected(a, b T) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+// Len returns the number of elements.
+func (u *UnionFind[T]) Len() int {
+	return len(u.parent)
+}
+
+// Sets returns the number of disjoint sets.
+func (u *UnionFind[T]) Sets() int {
+	return u.sets
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Faculty of Industrial Technology and Management, KMUTNB (Provided by FITM Elite)
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package unionfind
+
+import "testing"
+
+func TestUnionFind(t *testing.T) {
+	u := New(1, 2, 3, 4, 5)
+
+	if u.Len() != 5 || u.Sets() != 5 {
+		t.Errorf("Expected 5 elements in 5 sets, got %d in %d", u.Len(), u.Sets())
+	}
+
+	if !u.Union(1, 2) || !u.Union(3, 4) || !u.Union(2, 4) {
+		t.Errorf("Expected unions of different sets to succeed")
+	}
+
+	if u.Union(1, 3) {
+		t.Errorf("Expected union of the same set to fail")
+	}
+
+	if u.Sets() != 2 {
+		t.Errorf("Expected 2 sets, got %d", u.Sets())
+	}
+
+	if !u.Connected(1, 4) || u.Connected(1, 5) {
+		t.Errorf("Expected 1 to be connected to 4 and not to 5")
+	}
+
+	if u.Find(1) != u.Find(3) || u.Find(5) != 5 {
+		t.Errorf("Expected the same representative for 1 and 3, and 5 for 5")
+	}
+}
+
+func TestUnionFindAdd(t *testing.T) {
+	u := New[string]()
+
+	if !u.Add("A") || u.Add("A") {
+		t.Errorf("Expected only the first add to succeed")
+	}
+
+	if u.Contains("B") {
+		t.Errorf("Expected B not to exist")
+	}
+
+	// find adds missing elements as new sets.
+	if u.Find("B") != "B" || !u.Contains("B") || u.Sets() != 2 {
+		t.Errorf("Expected B to be added as a new set, got %d sets", u.Sets())
+	}
+}
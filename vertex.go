@@ -20,7 +20,11 @@
 
 package grafik
 
-import "github.com/fitm-elite/grafik/options"
+import (
+	"sync"
+
+	"github.com/fitm-elite/grafik/options"
+)
 
 // VertexPath represents path of vertex
 type VertexPath[T comparable] struct {
@@ -38,14 +42,41 @@ func (v VertexPath[T]) GetAverageLength() float64 {
 	return v.AverageLength
 }
 
+// VertexScore represents score of vertex
+type VertexScore[T comparable] struct {
+	VertexLabel T
+	Score       float64
+}
+
+// GetLabel returns label of vertex score
+func (v VertexScore[T]) GetLabel() T {
+	return v.VertexLabel
+}
+
+// GetScore returns score of vertex score
+func (v VertexScore[T]) GetScore() float64 {
+	return v.Score
+}
+
 // Vertex represents a node or point in a graph
+//
+// The degrees of a vertex count its distinct neighbors, so the parallel
+// edges of a multigraph add one to them. The edge counts, such as
+// OutEdgeCount, count each parallel edge on its own.
 type Vertex[T comparable] struct {
 	label    T
 	inDegree int
 
-	neighbors []*Vertex[T]
+	inEdges  int // the number of edges going to the vertex, parallel edges included.
+	outEdges int // the number of edges going from the vertex, parallel edges included.
+
+	neighbors     []*Vertex[T]
+	neighborIndex map[T]*Vertex[T] // the neighbors by label, for constant time lookups.
+	inNeighbors   []*Vertex[T]     // the vertices with an edge to this vertex.
 
 	properties options.VertexProperties
+
+	mu *sync.RWMutex // the lock of the concurrent graph that owns the vertex, nil otherwise.
 }
 
 func NewVertex[T comparable](label T, opts ...options.VertexOptionFunc) *Vertex[T] {
@@ -57,25 +88,86 @@ func NewVertex[T comparable](label T, opts ...options.VertexOptionFunc) *Vertex[
 	return v
 }
 
-// NeighborByLabel iterates over the neighbor slice and returns the
+// rlock locks the owner graph for reading, if the vertex belongs to a
+// concurrent graph.
+func (v *Vertex[T]) rlock() {
+	if v.mu != nil {
+		v.mu.RLock()
+	}
+}
+
+// runlock undoes a single rlock call.
+func (v *Vertex[T]) runlock() {
+	if v.mu != nil {
+		v.mu.RUnlock()
+	}
+}
+
+// NeighborByLabel looks up the neighbor index and returns the
 // vertex which its label is equal to the input label.
 //
 // It returns nil if there is no neighbor with that label.
 func (v *Vertex[T]) NeighborByLabel(label T) *Vertex[T] {
-	for i := range v.neighbors {
-		if v.neighbors[i].label == label {
-			return v.neighbors[i]
-		}
-	}
+	v.rlock()
+	defer v.runlock()
 
-	return nil
+	return v.neighborIndex[label]
 }
 
 // HasNeighbor checks if the input vertex is the neighbor of the
 // current node or not. It returns 'true' if it finds the input
 // in the neighbors. Otherwise, returns 'false'.
 func (v *Vertex[T]) HasNeighbor(vertex *Vertex[T]) bool {
-	return v.NeighborByLabel(vertex.label) != nil
+	v.rlock()
+	defer v.runlock()
+
+	_, ok := v.neighborIndex[vertex.label]
+	return ok
+}
+
+// addNeighbor appends the vertex to the neighbor slice and the neighbor index.
+func (v *Vertex[T]) addNeighbor(neighbor *Vertex[T]) {
+	if v.neighborIndex == nil {
+		v.neighborIndex = make(map[T]*Vertex[T])
+	}
+
+	v.neighbors = append(v.neighbors, neighbor)
+	v.neighborIndex[neighbor.label] = neighbor
+}
+
+// removeNeighbor removes the neighbor with the input label from the
+// neighbor slice and the neighbor index. The remaining neighbors are
+// copied to a new slice, so the slices that have been handed out before
+// are left untouched.
+//
+// It returns 'false' if there is no neighbor with that label.
+func (v *Vertex[T]) removeNeighbor(label T) bool {
+	if _, ok := v.neighborIndex[label]; !ok {
+		return false
+	}
+
+	delete(v.neighborIndex, label)
+
+	return removeByLabel(&v.neighbors, label)
+}
+
+// removeInNeighbor removes the vertex with the input label from the
+// incoming neighbor slice, the same way as removeNeighbor.
+func (v *Vertex[T]) removeInNeighbor(label T) bool {
+	return removeByLabel(&v.inNeighbors, label)
+}
+
+// removeByLabel removes the vertex with the input label from the slice
+// by copying the remaining vertices to a new slice.
+func removeByLabel[T comparable](vertices *[]*Vertex[T], label T) bool {
+	for i, v := range *vertices {
+		if v.label == label {
+			*vertices = append((*vertices)[:i:i], (*vertices)[i+1:]...)
+			return true
+		}
+	}
+
+	return false
 }
 
 // Label returns vertex label.
@@ -83,34 +175,110 @@ func (v *Vertex[T]) Label() T {
 	return v.label
 }
 
-// InDegree returns the number of incoming edges to the current vertex.
+// InDegree returns the number of vertices that have an edge going to the
+// current vertex.
 func (v *Vertex[T]) InDegree() int {
+	v.rlock()
+	defer v.runlock()
+
 	return v.inDegree
 }
 
-// OutDegree returns the number of outgoing edges to the current vertex.
+// OutDegree returns the number of vertices that the current vertex has an
+// edge going to.
 func (v *Vertex[T]) OutDegree() int {
+	v.rlock()
+	defer v.runlock()
+
 	return len(v.neighbors)
 }
 
 // Degree returns the total degree of the vertex which is the sum of in and out degrees.
 func (v *Vertex[T]) Degree() int {
-	return v.inDegree + v.OutDegree()
+	v.rlock()
+	defer v.runlock()
+
+	return v.inDegree + len(v.neighbors)
 }
 
-// Neighbors returns a copy of neighbor slice.
+// InEdgeCount returns the number of incoming edges to the current vertex.
+// Unlike InDegree, each parallel edge of a multigraph is counted.
+func (v *Vertex[T]) InEdgeCount() int {
+	v.rlock()
+	defer v.runlock()
+
+	return v.inEdges
+}
+
+// OutEdgeCount returns the number of outgoing edges from the current vertex.
+// Unlike OutDegree, each parallel edge of a multigraph is counted.
+func (v *Vertex[T]) OutEdgeCount() int {
+	v.rlock()
+	defer v.runlock()
+
+	return v.outEdges
+}
+
+// EdgeCount returns the sum of the in and out edge counts. Unlike Degree,
+// each parallel edge of a multigraph is counted.
+func (v *Vertex[T]) EdgeCount() int {
+	v.rlock()
+	defer v.runlock()
+
+	return v.inEdges + v.outEdges
+}
+
+// Neighbors returns the neighbor slice without copying it, so it doesn't
+// allocate. The slice must not be modified. It stays valid while the graph
+// changes, because removing a neighbor copies the slice, and appending to
+// the returned slice never writes into the one of the vertex.
 func (v *Vertex[T]) Neighbors() []*Vertex[T] {
-	neighbors := make([]*Vertex[T], 0, len(v.neighbors))
-	for idx := range v.neighbors {
-		clone := &Vertex[T]{}
-		*clone = *v.neighbors[idx]
-		neighbors = append(neighbors, clone)
-	}
+	v.rlock()
+	defer v.runlock()
+
+	return v.neighbors[:len(v.neighbors):len(v.neighbors)]
+}
 
-	return neighbors
+// InNeighbors returns the slice of vertices that have an edge going to
+// the current vertex, the same way as Neighbors. In undirected graph, they
+// are the same vertices as the neighbors.
+func (v *Vertex[T]) InNeighbors() []*Vertex[T] {
+	v.rlock()
+	defer v.runlock()
+
+	return v.inNeighbors[:len(v.inNeighbors):len(v.inNeighbors)]
 }
 
 // Weight returns vertex weight.
 func (v *Vertex[T]) Weight() float64 {
 	return v.properties.Weight()
 }
+
+// Attr returns the vertex attribute with the input key.
+// It returns 'false' if the attribute doesn't exist.
+func (v *Vertex[T]) Attr(key string) (any, bool) {
+	return v.properties.Attr(key)
+}
+
+// Attrs returns a copy of all vertex attributes.
+func (v *Vertex[T]) Attrs() map[string]any {
+	return v.properties.Attrs()
+}
+
+// VertexAttrAs returns the attribute of the input vertex with the input key
+// as type A. It returns 'false' if the attribute doesn't exist, or if it
+// has a different type.
+func VertexAttrAs[A any, T comparable](v *Vertex[T], key string) (A, bool) {
+	return attrAs[A](v.Attr(key))
+}
+
+// attrAs converts the attribute value to type A.
+func attrAs[A any](value any, ok bool) (A, bool) {
+	if !ok {
+		var zero A
+		return zero, false
+	}
+
+	typed, ok := value.(A)
+	return typed, ok
+}
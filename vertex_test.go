@@ -48,3 +48,51 @@ func TestVertexWeightFunc(t *testing.T) {
 		t.Errorf(testErrMsgNotEqual, weight, vBWeight)
 	}
 }
+
+func TestVertexAttrFunc(t *testing.T) {
+	vA := NewVertex("A", options.WithVertexAttr("name", "Bangkok"), options.WithVertexAttr("population", 5))
+
+	name, ok := vA.Attr("name")
+	if !ok || name != "Bangkok" {
+		t.Errorf(testErrMsgNotEqual, "Bangkok", name)
+	}
+
+	if _, ok = vA.Attr("colour"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	population, ok := VertexAttrAs[int](vA, "population")
+	if !ok || population != 5 {
+		t.Errorf(testErrMsgNotEqual, 5, population)
+	}
+
+	if _, ok = VertexAttrAs[string](vA, "population"); ok {
+		t.Error(testErrMsgNotFalse)
+	}
+
+	// changing the returned attributes doesn't change the vertex.
+	attrs := vA.Attrs()
+	attrs["name"] = "Chiang Mai"
+	if name, _ = vA.Attr("name"); name != "Bangkok" {
+		t.Errorf(testErrMsgNotEqual, "Bangkok", name)
+	}
+
+	if len(NewVertex("B").Attrs()) != 0 {
+		t.Errorf(testErrMsgWrongLen, 0, len(NewVertex("B").Attrs()))
+	}
+}
+
+func TestNeighborsAttr(t *testing.T) {
+	g := New[string]()
+
+	vA := g.AddVertexByLabel("A")
+	vB := g.AddVertexByLabel("B", options.WithVertexAttr("colour", "red"))
+
+	_, _ = g.AddEdge(vA, vB)
+
+	// the neighbors carry the attributes along.
+	colour, ok := VertexAttrAs[string](vA.Neighbors()[0], "colour")
+	if !ok || colour != "red" {
+		t.Errorf(testErrMsgNotEqual, "red", colour)
+	}
+}